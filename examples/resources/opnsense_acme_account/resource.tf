@@ -0,0 +1,12 @@
+resource "opnsense_acme_account" "letsencrypt" {
+  name = "letsencrypt"
+  email = "hostmaster@example.com"
+  ca = "letsencrypt"
+}
+
+// Local test CA, e.g. Pebble
+resource "opnsense_acme_account" "pebble" {
+  name = "pebble"
+  ca = "custom"
+  custom_ca = "https://pebble.example.com:14000/dir"
+}
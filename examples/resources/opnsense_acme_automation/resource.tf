@@ -0,0 +1,10 @@
+resource "opnsense_acme_automation" "restart_haproxy" {
+  name = "restart-haproxy"
+  type = "restart_haproxy"
+}
+
+resource "opnsense_acme_automation" "reload_unbound" {
+  name = "reload-unbound"
+  type = "configd"
+  configd_command = "unbound restart"
+}
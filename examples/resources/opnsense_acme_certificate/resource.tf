@@ -0,0 +1,30 @@
+resource "opnsense_acme_account" "letsencrypt" {
+  name = "letsencrypt"
+  email = "hostmaster@example.com"
+}
+
+resource "opnsense_acme_validation" "cloudflare" {
+  name = "cloudflare"
+  method = "dns01"
+
+  dns_service = "dns_cf"
+  dns_credentials = {
+    dns_cf_token = var.cloudflare_token
+  }
+}
+
+resource "opnsense_acme_automation" "restart_haproxy" {
+  name = "restart-haproxy"
+  type = "restart_haproxy"
+}
+
+resource "opnsense_acme_certificate" "www" {
+  common_name = "www.example.com"
+  alt_names = ["example.com"]
+
+  account = opnsense_acme_account.letsencrypt.id
+  validation = opnsense_acme_validation.cloudflare.id
+  key_type = "ec-prime256v1"
+
+  automations = [opnsense_acme_automation.restart_haproxy.id]
+}
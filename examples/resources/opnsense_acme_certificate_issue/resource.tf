@@ -0,0 +1,22 @@
+resource "opnsense_acme_certificate" "www" {
+  common_name = "www.example.com"
+  alt_names = ["example.com"]
+
+  account = opnsense_acme_account.letsencrypt.id
+  validation = opnsense_acme_validation.cloudflare.id
+}
+
+// Issue the certificate again whenever its domains change
+resource "opnsense_acme_certificate_issue" "www" {
+  certificate = opnsense_acme_certificate.www.id
+
+  triggers = {
+    common_name = opnsense_acme_certificate.www.common_name
+    alt_names = join(",", opnsense_acme_certificate.www.alt_names)
+  }
+}
+
+// Reference ID of the certificate in the trust store, e.g. for HAProxy
+output "www_cert_refid" {
+  value = opnsense_acme_certificate_issue.www.cert_refid
+}
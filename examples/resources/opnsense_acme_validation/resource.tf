@@ -0,0 +1,24 @@
+variable "cloudflare_token" {
+  type = string
+  sensitive = true
+}
+
+// DNS-01 challenge using the Cloudflare API
+resource "opnsense_acme_validation" "cloudflare" {
+  name = "cloudflare"
+  method = "dns01"
+
+  dns_service = "dns_cf"
+  dns_credentials = {
+    dns_cf_token = var.cloudflare_token
+  }
+}
+
+// HTTP-01 challenge answered by HAProxy
+resource "opnsense_acme_validation" "haproxy" {
+  name = "haproxy"
+  method = "http01"
+
+  http_service = "haproxy"
+  haproxy_frontends = ["5c9d5a2e-3b8f-4a8e-9d3c-1f2e3d4c5b6a"]
+}
@@ -0,0 +1,9 @@
+resource "opnsense_auth_group" "unbound_admins" {
+  name = "unbound-admins"
+  description = "Manage Unbound DNS"
+
+  privileges = [
+    "page-services-unbound",
+    "page-services-unbound-overrides",
+  ]
+}
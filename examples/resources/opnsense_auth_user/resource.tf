@@ -0,0 +1,20 @@
+resource "opnsense_auth_group" "admins" {
+  name = "network-admins"
+  privileges = ["page-all"]
+}
+
+resource "opnsense_auth_user" "alice" {
+  name = "alice"
+  full_name = "Alice Example"
+  email = "alice@example.com"
+
+  password = var.alice_password
+  groups = [opnsense_auth_group.admins.gid]
+
+  shell = "/bin/sh"
+  authorized_keys = [
+    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExampleKeyOnlyForDocumentation alice@laptop",
+  ]
+
+  expires = "2030-12-31"
+}
@@ -0,0 +1,23 @@
+// Least-privilege user for managing Unbound
+resource "opnsense_auth_group" "unbound" {
+  name = "terraform-unbound"
+  privileges = ["page-services-unbound", "page-services-unbound-overrides"]
+}
+
+resource "opnsense_auth_user" "terraform_unbound" {
+  name = "terraform-unbound"
+  scrambled_password = true
+  groups = [opnsense_auth_group.unbound.gid]
+}
+
+resource "opnsense_auth_user_apikey" "terraform_unbound" {
+  user = opnsense_auth_user.terraform_unbound.name
+}
+
+// Provider using the new API key
+provider "opnsense" {
+  alias = "unbound"
+  uri = "https://opnsense.example.com"
+  api_key = opnsense_auth_user_apikey.terraform_unbound.key
+  api_secret = opnsense_auth_user_apikey.terraform_unbound.secret
+}
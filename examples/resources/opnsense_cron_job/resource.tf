@@ -0,0 +1,18 @@
+// Refresh URL table aliases every 6 hours
+resource "opnsense_cron_job" "refresh_aliases" {
+  minutes = "0"
+  hours   = "*/6"
+
+  command     = "filter refresh_aliases"
+  description = "Refresh URL table aliases"
+}
+
+// Nightly remote backup, on weekdays only
+resource "opnsense_cron_job" "backup" {
+  minutes  = "30"
+  hours    = "2"
+  weekdays = "1-5"
+
+  command     = "system remote backup"
+  description = "Nightly backup"
+}
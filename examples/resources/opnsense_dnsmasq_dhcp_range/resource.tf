@@ -0,0 +1,9 @@
+resource "opnsense_dnsmasq_dhcp_range" "lan" {
+  description = "LAN clients"
+
+  interface = "lan"
+  start_address = "192.168.1.100"
+  end_address = "192.168.1.200"
+  domain = "lan.example.com"
+  lease_time = 43200
+}
@@ -0,0 +1,12 @@
+resource "opnsense_dnsmasq_domain_override" "internal" {
+  description = "Forward internal zone"
+
+  domain = "internal.example.com"
+  server = "10.0.0.53"
+}
+
+// Using a nondefault port
+resource "opnsense_dnsmasq_domain_override" "lab" {
+  domain = "lab.example.com"
+  server = "10.0.1.53@5353"
+}
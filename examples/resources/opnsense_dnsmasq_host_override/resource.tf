@@ -0,0 +1,20 @@
+// DNS only
+resource "opnsense_dnsmasq_host_override" "nas" {
+  description = "NAS"
+
+  hostname = "nas"
+  domain = "example.com"
+  server = "192.168.1.10"
+}
+
+// Static DHCP host
+resource "opnsense_dnsmasq_host_override" "printer" {
+  description = "Office printer"
+
+  hostname = "printer"
+  domain = "example.com"
+  server = "192.168.1.20"
+
+  mac_addresses = ["00:11:22:33:44:55"]
+  lease_time = 86400
+}
@@ -33,3 +33,34 @@ resource "opnsense_firewall_alias" "example_two" {
 
   description = "Example two"
 }
+
+// URL table, refreshed by a cron job
+resource "opnsense_firewall_alias" "example_three" {
+  name = "example_three"
+
+  type = "urltable"
+  content = [
+    "https://www.spamhaus.org/drop/drop.txt"
+  ]
+  update_freq = 1
+
+  description = "Spamhaus DROP list"
+}
+
+resource "opnsense_cron_job" "example_three_refresh" {
+  minutes = "0"
+  hours   = "*/6"
+
+  command     = "filter refresh_aliases"
+  description = "Refresh URL table aliases"
+}
+
+// Large network list, read from a file with one network per line
+resource "opnsense_firewall_alias" "example_four" {
+  name = "example_four"
+
+  type         = "network"
+  content_file = "${path.module}/threat_feed.txt"
+
+  description = "Threat feed networks"
+}
@@ -0,0 +1,18 @@
+resource "opnsense_firewall_alias" "blocklist" {
+  name = "blocklist"
+
+  type        = "external"
+  description = "Addresses blocked during incidents"
+}
+
+// Block a single address
+resource "opnsense_firewall_alias_entry" "example_one" {
+  alias   = opnsense_firewall_alias.blocklist.name
+  address = "192.0.2.1"
+}
+
+// Block a network
+resource "opnsense_firewall_alias_entry" "example_two" {
+  alias   = opnsense_firewall_alias.blocklist.name
+  address = "198.51.100.0/24"
+}
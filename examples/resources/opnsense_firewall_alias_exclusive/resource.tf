@@ -0,0 +1,29 @@
+resource "opnsense_firewall_alias" "web_servers" {
+  name = "web_servers"
+
+  type = "host"
+  content = [
+    "10.8.0.10",
+    "10.8.0.11",
+  ]
+}
+
+resource "opnsense_firewall_alias" "blocked_countries" {
+  name = "blocked_countries"
+
+  type = "geoip"
+  content = [
+    "KP",
+  ]
+}
+
+// Delete any alias not defined above. Built-in aliases (e.g. bogons,
+// sshlockout) are never deleted.
+resource "opnsense_firewall_alias_exclusive" "all" {
+  managed_ids = [
+    opnsense_firewall_alias.web_servers.id,
+    opnsense_firewall_alias.blocked_countries.id,
+  ]
+
+  delete_unmanaged = true
+}
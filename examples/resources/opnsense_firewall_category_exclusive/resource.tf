@@ -0,0 +1,15 @@
+resource "opnsense_firewall_category" "servers" {
+  name  = "servers"
+  color = "ffaa00"
+}
+
+// Report categories created outside of Terraform
+resource "opnsense_firewall_category_exclusive" "all" {
+  managed_ids = [
+    opnsense_firewall_category.servers.id,
+  ]
+}
+
+output "unmanaged_categories" {
+  value = opnsense_firewall_category_exclusive.all.unmanaged
+}
@@ -15,12 +15,12 @@ resource "opnsense_firewall_filter" "example_one" {
   protocol    = "UDP"
 
   source = {
-    net    = "any"
+    net    = ["any"]
     invert = true
   }
 
   destination = {
-    net    = "examplealias"
+    net    = ["examplealias"]
     port   = 443
   }
 
@@ -38,11 +38,11 @@ resource "opnsense_firewall_filter" "example_two" {
   protocol  = "TCP"
 
   source = {
-    net = "wan" # This is equiv. to WAN Net
+    net = ["wan"] # This is equiv. to WAN Net
   }
 
   destination = {
-    net  = "10.8.0.1"
+    net  = ["10.8.0.1"]
     port = 443
   }
 
@@ -59,14 +59,72 @@ resource "opnsense_firewall_filter" "example_three" {
   protocol  = "TCP"
 
   source = {
-    net = "192.168.0.0/16"
+    net = ["192.168.0.0/16"]
   }
 
   destination = {
-    net  = "wanip" # This is equiv. to WAN Address
+    net  = ["wanip"] # This is equiv. to WAN Address
     port = 443
   }
 
   description = "example rule"
   log         = true
-}
\ No newline at end of file
+}
+
+// Rate limit new SSH connections per source, and tag the traffic
+resource "opnsense_firewall_filter" "ssh" {
+  action    = "pass"
+  interface = ["wan"]
+  direction = "in"
+  protocol  = "TCP"
+
+  destination = {
+    net  = ["wanip"]
+    port = 22
+  }
+
+  tcp_flags        = ["syn"]
+  tcp_flags_out_of = ["syn", "ack"]
+
+  max_source_connections            = 10
+  max_source_connection_rate        = 5
+  max_source_connection_rate_window = 30
+
+  tag         = "ssh"
+  description = "Rate limited SSH"
+}
+
+// Both address families, several sources
+resource "opnsense_firewall_filter" "dns" {
+  action      = "pass"
+  interface   = ["lan"]
+  direction   = "in"
+  ip_protocol = "inet46"
+  protocol    = "TCP/UDP"
+
+  source = {
+    net = ["lan", "opt1", "examplealias"]
+  }
+
+  destination = {
+    net  = ["lanip"]
+    port = 53
+  }
+
+  description = "DNS to the firewall"
+}
+
+// Only allow the guest network out during an existing schedule
+resource "opnsense_firewall_filter" "guest_business_hours" {
+  action    = "pass"
+  interface = ["opt2"]
+  direction = "in"
+  protocol  = "any"
+
+  source = {
+    net = ["opt2"]
+  }
+
+  schedule    = "BusinessHours"
+  description = "Guest access during business hours"
+}
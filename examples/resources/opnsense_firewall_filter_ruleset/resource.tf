@@ -0,0 +1,66 @@
+// Order rules managed by opnsense_firewall_filter, which leave sequence unset
+resource "opnsense_firewall_filter" "allow_dns" {
+  action    = "pass"
+  interface = ["lan"]
+  direction = "in"
+  protocol  = "UDP"
+
+  destination = {
+    net  = ["lanip"]
+    port = 53
+  }
+
+  description = "Allow DNS to the firewall"
+}
+
+resource "opnsense_firewall_filter" "block_lan" {
+  action    = "block"
+  interface = ["lan"]
+  direction = "in"
+  protocol  = "any"
+
+  description = "Block everything else"
+}
+
+resource "opnsense_firewall_filter_ruleset" "lan" {
+  interface = "lan"
+
+  rules = [
+    opnsense_firewall_filter.allow_dns.id,
+    opnsense_firewall_filter.block_lan.id,
+  ]
+}
+
+// Define the rules inline, removing any rule added in between
+resource "opnsense_firewall_filter_ruleset" "guest" {
+  interface = "opt2"
+
+  inline_rules = [
+    {
+      action    = "block"
+      direction = "in"
+      protocol  = "any"
+
+      destination = {
+        net = ["lan"]
+      }
+
+      description = "Block guests from the LAN"
+    },
+    {
+      action    = "pass"
+      direction = "in"
+      protocol  = "any"
+
+      source = {
+        net = ["opt2"]
+      }
+
+      description = "Allow guests out"
+    },
+  ]
+
+  sequence_start = 1000
+  sequence_step  = 10
+  exclusive      = true
+}
@@ -0,0 +1,16 @@
+resource "opnsense_ipsec_connection" "branch" {
+  description = "Branch office"
+
+  local_addresses = ["203.0.113.1"]
+  remote_addresses = ["198.51.100.1"]
+}
+
+resource "opnsense_ipsec_child" "branch" {
+  connection = opnsense_ipsec_connection.branch.id
+  description = "Branch office LAN"
+
+  esp_proposals = ["aes256gcm16-modp2048"]
+
+  local_networks = ["192.168.1.0/24"]
+  remote_networks = ["192.168.20.0/24"]
+}
@@ -0,0 +1,11 @@
+// Site-to-site tunnel to a branch office
+resource "opnsense_ipsec_connection" "branch" {
+  description = "Branch office"
+
+  proposals = ["aes256-sha256-modp2048"]
+
+  local_addresses = ["203.0.113.1"]
+  remote_addresses = ["198.51.100.1"]
+
+  dpd_delay = 10
+}
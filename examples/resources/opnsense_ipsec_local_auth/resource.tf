@@ -0,0 +1,14 @@
+resource "opnsense_ipsec_connection" "branch" {
+  description = "Branch office"
+
+  local_addresses = ["203.0.113.1"]
+  remote_addresses = ["198.51.100.1"]
+}
+
+resource "opnsense_ipsec_local_auth" "branch" {
+  connection = opnsense_ipsec_connection.branch.id
+  description = "Branch office"
+
+  auth = "psk"
+  identity = "203.0.113.1"
+}
@@ -0,0 +1,12 @@
+variable "branch_psk" {
+  type = string
+  sensitive = true
+}
+
+resource "opnsense_ipsec_psk" "branch" {
+  description = "Branch office"
+
+  identity = "203.0.113.1"
+  remote_identity = "198.51.100.1"
+  key = var.branch_psk
+}
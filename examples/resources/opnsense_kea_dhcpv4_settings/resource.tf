@@ -0,0 +1,19 @@
+// Listen on LAN and on the interface the VLAN is assigned to
+resource "opnsense_kea_dhcpv4_settings" "settings" {
+  interfaces = ["lan", "opt1"]
+
+  valid_lifetime = 7200
+}
+
+resource "opnsense_kea_dhcpv4_subnet" "vlan_subnet" {
+  subnet = "10.0.10.0/24"
+  pools = ["10.0.10.100-10.0.10.200"]
+}
+
+// Apply the changes
+resource "opnsense_kea_reconfigure" "kea" {
+  triggers = {
+    settings    = sha1(jsonencode(opnsense_kea_dhcpv4_settings.settings))
+    vlan_subnet = sha1(jsonencode(opnsense_kea_dhcpv4_subnet.vlan_subnet))
+  }
+}
@@ -0,0 +1,33 @@
+// Serve addresses on a VLAN
+resource "opnsense_interfaces_vlan" "vlan" {
+  description = "Example vlan"
+  tag = 10
+  parent = "vtnet0"
+}
+
+// Listen on the interface the VLAN is assigned to
+resource "opnsense_kea_dhcpv4_settings" "settings" {
+  interfaces = ["opt1"]
+}
+
+resource "opnsense_kea_dhcpv4_subnet" "vlan_subnet" {
+  subnet = "10.0.${opnsense_interfaces_vlan.vlan.tag}.0/24"
+  description = "DHCP for ${opnsense_interfaces_vlan.vlan.description}"
+
+  pools = [
+    "10.0.${opnsense_interfaces_vlan.vlan.tag}.100-10.0.${opnsense_interfaces_vlan.vlan.tag}.200",
+  ]
+
+  auto_collect = false
+  routers = ["10.0.${opnsense_interfaces_vlan.vlan.tag}.1"]
+  dns_servers = ["10.0.${opnsense_interfaces_vlan.vlan.tag}.1", "9.9.9.9"]
+  domain_name = "vlan.example.com"
+}
+
+// Apply the changes
+resource "opnsense_kea_reconfigure" "kea" {
+  triggers = {
+    settings    = sha1(jsonencode(opnsense_kea_dhcpv4_settings.settings))
+    vlan_subnet = sha1(jsonencode(opnsense_kea_dhcpv4_subnet.vlan_subnet))
+  }
+}
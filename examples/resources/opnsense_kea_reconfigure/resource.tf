@@ -0,0 +1,18 @@
+resource "opnsense_kea_dhcpv4_subnet" "lan" {
+  subnet = "192.168.1.0/24"
+  pools = ["192.168.1.100-192.168.1.200"]
+}
+
+resource "opnsense_kea_dhcpv4_reservation" "printer" {
+  subnet = opnsense_kea_dhcpv4_subnet.lan.id
+  ip_address = "192.168.1.20"
+  mac_address = "00:11:22:33:44:55"
+}
+
+// Reconfigure Kea once, after all of the above have been applied
+resource "opnsense_kea_reconfigure" "kea" {
+  triggers = {
+    lan     = sha1(jsonencode(opnsense_kea_dhcpv4_subnet.lan))
+    printer = sha1(jsonencode(opnsense_kea_dhcpv4_reservation.printer))
+  }
+}
@@ -0,0 +1,12 @@
+resource "opnsense_openvpn_client_override" "alice" {
+  description = "Fixed address for alice"
+
+  servers = [
+    opnsense_openvpn_instance.roadwarrior.id,
+  ]
+
+  common_name = "alice"
+  tunnel_network = "10.8.0.10/24"
+
+  local_networks = ["192.168.10.0/24"]
+}
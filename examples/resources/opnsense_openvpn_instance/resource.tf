@@ -0,0 +1,42 @@
+resource "opnsense_openvpn_static_key" "tls_crypt" {
+  description = "Road warrior tls-crypt key"
+}
+
+// Road warrior server
+resource "opnsense_openvpn_instance" "roadwarrior" {
+  description = "Road warriors"
+
+  role = "server"
+  protocol = "udp"
+  port = 1194
+
+  tunnel_network = "10.8.0.0/24"
+
+  // Reference IDs from the trust store
+  cert = "64f0c1a2b3c4d"
+  ca = "64f0c1a2b3c4e"
+  tls_key = opnsense_openvpn_static_key.tls_crypt.id
+
+  auth_servers = ["Local Database"]
+  username_as_common_name = true
+
+  push_routes = ["192.168.1.0/24"]
+  dns_servers = ["192.168.1.1"]
+  dns_domain = "example.com"
+
+  keepalive_interval = 10
+  keepalive_timeout = 60
+}
+
+// Client connecting to a remote site
+resource "opnsense_openvpn_instance" "branch" {
+  description = "Branch office"
+
+  role = "client"
+  remote = ["vpn.example.com:1194"]
+
+  cert = "64f0c1a2b3c4f"
+  ca = "64f0c1a2b3c4e"
+
+  routes = ["192.168.20.0/24"]
+}
@@ -0,0 +1,5 @@
+// Key is generated by OPNsense
+resource "opnsense_openvpn_static_key" "tls_crypt" {
+  description = "Road warrior tls-crypt key"
+  mode = "crypt"
+}
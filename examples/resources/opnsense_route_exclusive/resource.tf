@@ -0,0 +1,23 @@
+variable "routes" {
+  type = map(string)
+  default = {
+    "10.10.0.0/16" = "VPN_GW"
+    "10.20.0.0/16" = "VPN_GW"
+  }
+}
+
+resource "opnsense_route" "vpn" {
+  for_each = var.routes
+
+  network = each.key
+  gateway = each.value
+}
+
+resource "opnsense_route_exclusive" "all" {
+  managed_ids = [for route in opnsense_route.vpn : route.id]
+
+  // Keep a route managed by hand
+  ignored_ids = ["00000000-0000-0000-0000-000000000000"]
+
+  delete_unmanaged = true
+}
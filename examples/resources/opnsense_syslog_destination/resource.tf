@@ -0,0 +1,22 @@
+// Forward the firewall log (rules with `log = true`) to a SIEM over TLS
+resource "opnsense_syslog_destination" "siem" {
+  transport = "tls4"
+  hostname  = "siem.example.com"
+  port      = 6514
+
+  programs = ["filterlog"]
+  levels   = ["info", "notice", "warn", "err", "crit", "alert", "emerg"]
+
+  // Reference ID of a client certificate from the trust store
+  certificate = opnsense_trust_cert.syslog.refid
+  rfc5424     = true
+
+  description = "SIEM"
+}
+
+// Forward everything to a local collector
+resource "opnsense_syslog_destination" "collector" {
+  hostname = "192.168.1.20"
+
+  description = "Log collector"
+}
@@ -0,0 +1,8 @@
+// Upload of a 50 Mbit/s line
+resource "opnsense_trafficshaper_pipe" "upload" {
+  bandwidth      = 48
+  bandwidth_unit = "Mbit"
+
+  scheduler   = "fq_codel"
+  description = "Upload"
+}
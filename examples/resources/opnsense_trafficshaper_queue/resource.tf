@@ -0,0 +1,21 @@
+resource "opnsense_trafficshaper_pipe" "upload" {
+  bandwidth      = 48
+  bandwidth_unit = "Mbit"
+
+  description = "Upload"
+}
+
+// Prioritise VoIP over bulk traffic
+resource "opnsense_trafficshaper_queue" "voip" {
+  pipe   = opnsense_trafficshaper_pipe.upload.id
+  weight = 90
+
+  description = "VoIP"
+}
+
+resource "opnsense_trafficshaper_queue" "bulk" {
+  pipe   = opnsense_trafficshaper_pipe.upload.id
+  weight = 10
+
+  description = "Bulk"
+}
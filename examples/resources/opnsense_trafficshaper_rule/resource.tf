@@ -0,0 +1,27 @@
+// Send VoIP traffic from the phones to the VoIP queue
+resource "opnsense_trafficshaper_rule" "voip" {
+  sequence  = 10
+  interface = "wan"
+  direction = "out"
+  protocol  = "udp"
+
+  source = {
+    net = "192.168.10.0/24"
+  }
+
+  dscp   = ["ef"]
+  target = opnsense_trafficshaper_queue.voip.id
+
+  description = "VoIP"
+}
+
+// Everything else goes to the bulk queue
+resource "opnsense_trafficshaper_rule" "bulk" {
+  sequence  = 20
+  interface = "wan"
+  direction = "out"
+
+  target = opnsense_trafficshaper_queue.bulk.id
+
+  description = "Bulk"
+}
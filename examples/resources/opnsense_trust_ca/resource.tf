@@ -0,0 +1,28 @@
+// Internal root CA
+resource "opnsense_trust_ca" "root" {
+  description = "Internal root CA"
+
+  common_name = "Example Root CA"
+  country = "NL"
+  organization = "Example"
+
+  key_type = "ec-secp384r1"
+  lifetime = 3650
+}
+
+// Intermediate CA, signed by the root CA
+resource "opnsense_trust_ca" "intermediate" {
+  description = "Internal intermediate CA"
+  parent_ca = opnsense_trust_ca.root.refid
+
+  common_name = "Example Intermediate CA"
+  lifetime = 1825
+}
+
+// Imported CA, only used to verify certificates
+resource "opnsense_trust_ca" "partner" {
+  description = "Partner CA"
+
+  method = "import"
+  certificate = file("partner-ca.pem")
+}
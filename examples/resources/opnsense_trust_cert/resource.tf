@@ -0,0 +1,27 @@
+resource "opnsense_trust_ca" "root" {
+  description = "Internal root CA"
+  common_name = "Example Root CA"
+}
+
+// Server certificate for the web GUI, renewed 30 days before it expires
+resource "opnsense_trust_cert" "webgui" {
+  description = "Web GUI"
+  ca = opnsense_trust_ca.root.refid
+
+  type = "server"
+  common_name = "fw.example.com"
+  dns_names = ["fw.example.com", "fw"]
+  ip_addresses = ["192.168.1.1"]
+
+  lifetime = 397
+  renew_before_days = 30
+}
+
+// Imported certificate
+resource "opnsense_trust_cert" "wildcard" {
+  description = "Wildcard certificate"
+
+  method = "import"
+  certificate = file("wildcard.pem")
+  private_key = file("wildcard.key")
+}
@@ -0,0 +1,27 @@
+// Allow queries from a VLAN subnet
+resource "opnsense_interfaces_vlan" "vlan" {
+  description = "Example vlan"
+  tag = 10
+  parent = "vtnet0"
+}
+
+resource "opnsense_unbound_acl" "vlan_acl" {
+  name = "vlan${opnsense_interfaces_vlan.vlan.tag}"
+  description = "Allow ${opnsense_interfaces_vlan.vlan.description}"
+
+  action = "allow"
+  networks = [
+    "10.0.${opnsense_interfaces_vlan.vlan.tag}.0/24",
+  ]
+}
+
+// Refuse queries from a set of networks
+resource "opnsense_unbound_acl" "refuse_acl" {
+  name = "guests"
+
+  action = "refuse"
+  networks = [
+    "192.168.100.0/24",
+    "fd00:100::/64",
+  ]
+}
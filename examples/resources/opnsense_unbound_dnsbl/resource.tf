@@ -0,0 +1,22 @@
+resource "opnsense_unbound_dnsbl" "dnsbl" {
+  enabled = true
+
+  // AdGuard and EasyList
+  type = ["ag", "el"]
+  lists = [
+    "https://example.com/blocklist.txt",
+  ]
+
+  whitelists = [
+    "docs.example.com",
+    ".*\\.trusted\\.example",
+  ]
+  blocklists = [
+    "ads.example.net",
+  ]
+  wildcards = [
+    "tracker.example.org",
+  ]
+
+  nxdomain = true
+}
@@ -0,0 +1,11 @@
+resource "opnsense_unbound_domain_override" "corp" {
+  domain = "corp.example.com"
+  server = "10.0.0.53"
+}
+
+// Report domain overrides created outside of Terraform
+resource "opnsense_unbound_domain_override_exclusive" "all" {
+  managed_ids = [
+    opnsense_unbound_domain_override.corp.id,
+  ]
+}
@@ -0,0 +1,20 @@
+resource "opnsense_unbound_host_override" "nas" {
+  hostname = "nas"
+  domain   = "example.com"
+  server   = "192.168.1.10"
+}
+
+resource "opnsense_unbound_host_alias" "storage" {
+  override = opnsense_unbound_host_override.nas.id
+
+  hostname = "storage"
+  domain   = "example.com"
+}
+
+resource "opnsense_unbound_host_alias_exclusive" "all" {
+  managed_ids = [
+    opnsense_unbound_host_alias.storage.id,
+  ]
+
+  delete_unmanaged = true
+}
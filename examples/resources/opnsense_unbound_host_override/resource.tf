@@ -30,3 +30,14 @@ resource "opnsense_unbound_host_override" "mx_override" {
   mx_priority = 10
   mx_host = "mail.example.dev"
 }
+
+// 'TXT' record
+resource "opnsense_unbound_host_override" "txt_override" {
+  description = "TXT record override"
+
+  type = "TXT"
+  hostname = "_dmarc"
+  domain = "example.com"
+
+  txtdata = "v=DMARC1; p=reject"
+}
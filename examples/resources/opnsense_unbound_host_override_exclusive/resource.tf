@@ -0,0 +1,24 @@
+resource "opnsense_unbound_host_override" "nas" {
+  hostname = "nas"
+  domain   = "example.com"
+  server   = "192.168.1.10"
+}
+
+resource "opnsense_unbound_host_overrides" "internal" {
+  tag = "terraform:internal"
+
+  records = {
+    "app.example.com" = {
+      value = "10.0.0.10"
+    }
+  }
+}
+
+resource "opnsense_unbound_host_override_exclusive" "all" {
+  managed_ids = setunion(
+    [opnsense_unbound_host_override.nas.id],
+    opnsense_unbound_host_overrides.internal.ids,
+  )
+
+  delete_unmanaged = true
+}
@@ -0,0 +1,43 @@
+resource "opnsense_unbound_host_overrides" "internal" {
+  tag = "terraform:internal"
+
+  records = {
+    "app.example.com" = {
+      value = "10.0.0.10"
+    }
+    "app6.example.com" = {
+      type  = "AAAA"
+      value = "fd00:abcd::10"
+    }
+    "example.com" = {
+      type        = "MX"
+      value       = "mail.example.com"
+      mx_priority = 10
+    }
+    "_dmarc.example.com" = {
+      type  = "TXT"
+      value = "v=DMARC1; p=reject"
+    }
+    "*.dev.example.com" = {
+      enabled = false
+      value   = "10.0.1.1"
+    }
+  }
+}
+
+// Records can be built from a map of names to addresses
+variable "hosts" {
+  type = map(string)
+  default = {
+    "db.example.com"  = "10.0.0.20"
+    "web.example.com" = "10.0.0.21"
+  }
+}
+
+resource "opnsense_unbound_host_overrides" "hosts" {
+  tag = "terraform:hosts"
+
+  records = {
+    for fqdn, ip in var.hosts : fqdn => { value = ip }
+  }
+}
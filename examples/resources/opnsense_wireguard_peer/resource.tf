@@ -0,0 +1,22 @@
+// Remote site
+resource "opnsense_wireguard_peer" "branch" {
+  name = "branch-office"
+  public_key = "Zp6T7OZVA2zsNYptmn6vvh9GSgEaxhb1c51Juikf0G4="
+
+  allowed_ips = [
+    "10.10.10.2/32",
+    "192.168.20.0/24",
+  ]
+
+  endpoint = "branch.example.com"
+  endpoint_port = 51820
+  keepalive = 25
+}
+
+// Road warrior, connecting to this firewall
+resource "opnsense_wireguard_peer" "laptop" {
+  name = "laptop"
+  public_key = "2Jt1zvT+bJgLu8x9Yhxv5oTk9k4rT1wXw8wBqfEVmkQ="
+
+  allowed_ips = ["10.10.10.3/32"]
+}
@@ -0,0 +1,24 @@
+resource "opnsense_wireguard_peer" "branch" {
+  name = "branch-office"
+  public_key = "Zp6T7OZVA2zsNYptmn6vvh9GSgEaxhb1c51Juikf0G4="
+  allowed_ips = ["10.10.10.2/32", "192.168.20.0/24"]
+  endpoint = "branch.example.com"
+}
+
+// Key pair is generated by OPNsense
+resource "opnsense_wireguard_server" "site2site" {
+  name = "site2site"
+  listen_port = 51820
+
+  tunnel_addresses = ["10.10.10.1/24"]
+  dns = ["10.10.10.1"]
+
+  peers = [
+    opnsense_wireguard_peer.branch.id,
+  ]
+}
+
+// Public key to configure on the remote side
+output "site2site_public_key" {
+  value = opnsense_wireguard_server.site2site.public_key
+}
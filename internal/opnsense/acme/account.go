@@ -0,0 +1,49 @@
+package acme
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var AccountOpts = api.ReqOpts{
+	AddEndpoint:         "/acmeclient/accounts/add",
+	GetEndpoint:         "/acmeclient/accounts/get",
+	UpdateEndpoint:      "/acmeclient/accounts/update",
+	DeleteEndpoint:      "/acmeclient/accounts/del",
+	ReconfigureEndpoint: acmeReconfigureEndpoint,
+	Monad:               "account",
+}
+
+// Data structs
+
+type Account struct {
+	Enabled     string          `json:"enabled"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Email       string          `json:"email"`
+	CA          api.SelectedMap `json:"ca"`
+	CustomCA    string          `json:"custom_ca"`
+	EABKeyId    string          `json:"eab_kid"`
+	EABHMACKey  string          `json:"eab_hmac"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the EAB HMAC key is not logged.
+
+func (c *Controller) AddAccount(ctx context.Context, resource *Account) (string, error) {
+	return apiutil.Add(c.Client(), ctx, AccountOpts, resource)
+}
+
+func (c *Controller) GetAccount(ctx context.Context, id string) (*Account, error) {
+	return apiutil.Get(c.Client(), ctx, AccountOpts, &Account{}, id)
+}
+
+func (c *Controller) UpdateAccount(ctx context.Context, id string, resource *Account) error {
+	return apiutil.Update(c.Client(), ctx, AccountOpts, resource, id)
+}
+
+func (c *Controller) DeleteAccount(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, AccountOpts, id)
+}
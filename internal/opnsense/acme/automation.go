@@ -0,0 +1,43 @@
+package acme
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var AutomationOpts = api.ReqOpts{
+	AddEndpoint:         "/acmeclient/actions/add",
+	GetEndpoint:         "/acmeclient/actions/get",
+	UpdateEndpoint:      "/acmeclient/actions/update",
+	DeleteEndpoint:      "/acmeclient/actions/del",
+	ReconfigureEndpoint: acmeReconfigureEndpoint,
+	Monad:               "action",
+}
+
+// Data structs
+
+type Automation struct {
+	Enabled        string          `json:"enabled"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	Type           api.SelectedMap `json:"type"`
+	ConfigdCommand api.SelectedMap `json:"configd_generic_command"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddAutomation(ctx context.Context, resource *Automation) (string, error) {
+	return api.Add(c.Client(), ctx, AutomationOpts, resource)
+}
+
+func (c *Controller) GetAutomation(ctx context.Context, id string) (*Automation, error) {
+	return api.Get(c.Client(), ctx, AutomationOpts, &Automation{}, id)
+}
+
+func (c *Controller) UpdateAutomation(ctx context.Context, id string, resource *Automation) error {
+	return api.Update(c.Client(), ctx, AutomationOpts, resource, id)
+}
+
+func (c *Controller) DeleteAutomation(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, AutomationOpts, id)
+}
@@ -0,0 +1,130 @@
+package acme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+	"time"
+)
+
+var CertificateOpts = api.ReqOpts{
+	AddEndpoint:         "/acmeclient/certificates/add",
+	GetEndpoint:         "/acmeclient/certificates/get",
+	UpdateEndpoint:      "/acmeclient/certificates/update",
+	DeleteEndpoint:      "/acmeclient/certificates/del",
+	ReconfigureEndpoint: acmeReconfigureEndpoint,
+	Monad:               "certificate",
+}
+
+const certificateSignEndpoint = "/acmeclient/certificates/sign"
+
+// Certificate status codes, as set by the ACME client
+const (
+	certificateStatusPending = "100"
+	certificateStatusIssued  = "200"
+)
+
+// certificatePollInterval is the time between checks for the result of
+// issuing a certificate.
+const certificatePollInterval = 5 * time.Second
+
+// Data structs
+
+type Certificate struct {
+	Enabled          string              `json:"enabled"`
+	Name             string              `json:"name"`
+	Description      string              `json:"description"`
+	AltNames         apiutil.OrderedList `json:"altNames"`
+	Account          api.SelectedMap     `json:"account"`
+	Validation       api.SelectedMap     `json:"validationMethod"`
+	KeyLength        api.SelectedMap     `json:"keyLength"`
+	OCSP             string              `json:"ocsp"`
+	Automations      api.SelectedMapList `json:"restartActions"`
+	AutoRenewal      string              `json:"autoRenewal"`
+	RenewInterval    string              `json:"renewInterval"`
+	CertRefId        string              `json:"certRefId,omitempty"`
+	StatusCode       string              `json:"statusCode,omitempty"`
+	StatusLastUpdate string              `json:"statusLastUpdate,omitempty"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddCertificate(ctx context.Context, resource *Certificate) (string, error) {
+	return api.Add(c.Client(), ctx, CertificateOpts, resource)
+}
+
+func (c *Controller) GetCertificate(ctx context.Context, id string) (*Certificate, error) {
+	return api.Get(c.Client(), ctx, CertificateOpts, &Certificate{}, id)
+}
+
+func (c *Controller) UpdateCertificate(ctx context.Context, id string, resource *Certificate) error {
+	return api.Update(c.Client(), ctx, CertificateOpts, resource, id)
+}
+
+func (c *Controller) DeleteCertificate(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, CertificateOpts, id)
+}
+
+// Actions
+
+// SignCertificate starts issuing (or renewing) a certificate. Signing runs
+// in the background, poll GetCertificate for the result.
+func (c *Controller) SignCertificate(ctx context.Context, id string) error {
+	// Depending on the plugin version, either is returned
+	resp := &struct {
+		Status string `json:"status"`
+		Result string `json:"result"`
+	}{}
+	err := apiutil.Do(c.Client(), ctx, "POST", fmt.Sprintf("%s/%s", certificateSignEndpoint, id), map[string]string{}, resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.Status != "ok" && resp.Result != "ok" {
+		return fmt.Errorf("certificate not signed. status: %s%s", resp.Status, resp.Result)
+	}
+
+	return nil
+}
+
+// IssueCertificate signs a certificate and waits, until ctx is done, for the
+// result. Returns the certificate once it is stored in the trust store.
+func (c *Controller) IssueCertificate(ctx context.Context, id string) (*Certificate, error) {
+	before, err := c.GetCertificate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.SignCertificate(ctx, id); err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(certificatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("timed out waiting for certificate to be issued")
+		case <-ticker.C:
+		}
+
+		cert, err := c.GetCertificate(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// Wait for the result of this signing attempt
+		if cert.StatusLastUpdate == before.StatusLastUpdate || cert.StatusCode == certificateStatusPending {
+			continue
+		}
+
+		if cert.StatusCode != certificateStatusIssued || cert.CertRefId == "" {
+			return nil, fmt.Errorf("certificate not issued. status code: %s, see the ACME client log for details", cert.StatusCode)
+		}
+
+		return cert, nil
+	}
+}
@@ -0,0 +1,16 @@
+package acme
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+const acmeReconfigureEndpoint = "/acmeclient/service/reconfigure"
+
+// Controller for acme
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
@@ -0,0 +1,118 @@
+package acme
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var ValidationOpts = api.ReqOpts{
+	AddEndpoint:         "/acmeclient/validations/add",
+	GetEndpoint:         "/acmeclient/validations/get",
+	UpdateEndpoint:      "/acmeclient/validations/update",
+	DeleteEndpoint:      "/acmeclient/validations/del",
+	ReconfigureEndpoint: acmeReconfigureEndpoint,
+	Monad:               "validation",
+}
+
+// Data structs
+
+type Validation struct {
+	Enabled           string              `json:"enabled"`
+	Name              string              `json:"name"`
+	Description       string              `json:"description"`
+	Method            api.SelectedMap     `json:"method"`
+	HTTPService       api.SelectedMap     `json:"http_service"`
+	HTTPAutoDiscovery string              `json:"http_opn_autodiscovery"`
+	HTTPInterface     api.SelectedMap     `json:"http_opn_interface"`
+	HTTPIPAddresses   string              `json:"http_opn_ipaddresses"`
+	HAProxyInject     string              `json:"http_haproxyInject"`
+	HAProxyFrontends  api.SelectedMapList `json:"http_haproxyFrontends"`
+	DNSService        api.SelectedMap     `json:"dns_service"`
+	DNSSleep          string              `json:"dns_sleep"`
+
+	// DNSCredentials holds the provider specific fields of the DNS API
+	// (e.g. `dns_cf_token`), which are flattened into the validation.
+	DNSCredentials map[string]string `json:"-"`
+}
+
+// validationFields are the fields of Validation which are not DNS API
+// credentials.
+var validationFields = map[string]bool{
+	"dns_service": true,
+	"dns_sleep":   true,
+}
+
+func (v *Validation) MarshalJSON() ([]byte, error) {
+	type validation Validation
+	data, err := json.Marshal((*validation)(v))
+	if err != nil {
+		return nil, err
+	}
+
+	// Flatten credentials into the validation
+	fields := map[string]any{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for k, val := range v.DNSCredentials {
+		fields[k] = val
+	}
+
+	return json.Marshal(fields)
+}
+
+func (v *Validation) UnmarshalJSON(data []byte) error {
+	type validation Validation
+	if err := json.Unmarshal(data, (*validation)(v)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	// Collect all DNS API fields, option fields are returned as maps
+	v.DNSCredentials = map[string]string{}
+	for k, raw := range fields {
+		if !strings.HasPrefix(k, "dns_") || validationFields[k] {
+			continue
+		}
+
+		var str string
+		if err := json.Unmarshal(raw, &str); err == nil {
+			v.DNSCredentials[k] = str
+			continue
+		}
+
+		var selected api.SelectedMap
+		if err := json.Unmarshal(raw, &selected); err == nil {
+			v.DNSCredentials[k] = selected.String()
+		}
+	}
+
+	return nil
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the DNS API credentials are not logged.
+
+func (c *Controller) AddValidation(ctx context.Context, resource *Validation) (string, error) {
+	return apiutil.Add(c.Client(), ctx, ValidationOpts, resource)
+}
+
+func (c *Controller) GetValidation(ctx context.Context, id string) (*Validation, error) {
+	return apiutil.Get(c.Client(), ctx, ValidationOpts, &Validation{}, id)
+}
+
+func (c *Controller) UpdateValidation(ctx context.Context, id string, resource *Validation) error {
+	return apiutil.Update(c.Client(), ctx, ValidationOpts, resource, id)
+}
+
+func (c *Controller) DeleteValidation(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, ValidationOpts, id)
+}
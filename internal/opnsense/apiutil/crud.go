@@ -0,0 +1,78 @@
+package apiutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+)
+
+/*
+	The CRUD operations of the api package log full request and response
+	bodies. Add, Get and Update behave the same, but send requests through Do,
+	so they must be used for objects holding secrets (e.g. private keys or
+	passwords). Deleting and reconfiguring does not send the object, so the
+	api package can still be used for these.
+*/
+
+// clientMutexKey is the key the api package serializes changes on, so that
+// changes are not sent while a service is being reconfigured.
+const clientMutexKey = "OPNSENSE"
+
+type setResp struct {
+	Result      string         `json:"result"`
+	UUID        string         `json:"uuid"`
+	Validations map[string]any `json:"validations,omitempty"`
+}
+
+func set[K any](c *api.Client, ctx context.Context, opts api.ReqOpts, resource *K, endpoint string) (string, error) {
+	api.GlobalMutexKV.Lock(clientMutexKey, ctx)
+	defer api.GlobalMutexKV.Unlock(clientMutexKey, ctx)
+
+	respJson := &setResp{}
+	err := Do(c, ctx, "POST", endpoint, map[string]*K{opts.Monad: resource}, respJson)
+	if err != nil {
+		return "", err
+	}
+
+	if respJson.Result != "saved" {
+		return "", fmt.Errorf("resource not changed. result: %s. errors: %s", respJson.Result, respJson.Validations)
+	}
+
+	// Reconfigure (i.e. restart) the OPNsense service
+	return respJson.UUID, c.ReconfigureService(ctx, opts.ReconfigureEndpoint)
+}
+
+// Add creates resource, like api.Add, without logging it.
+func Add[K any](c *api.Client, ctx context.Context, opts api.ReqOpts, resource *K) (string, error) {
+	return set(c, ctx, opts, resource, opts.AddEndpoint)
+}
+
+// Update updates resource, like api.Update, without logging it.
+func Update[K any](c *api.Client, ctx context.Context, opts api.ReqOpts, resource *K, id string) error {
+	_, err := set(c, ctx, opts, resource, fmt.Sprintf("%s/%s", opts.UpdateEndpoint, id))
+	return err
+}
+
+// Get reads resource, like api.Get, without logging it.
+func Get[K any](c *api.Client, ctx context.Context, opts api.ReqOpts, resource *K, id string) (*K, error) {
+	var respJson map[string]json.RawMessage
+
+	err := Do(c, ctx, "GET", fmt.Sprintf("%s/%s", opts.GetEndpoint, id), nil, &respJson)
+	if err != nil {
+		// OPNsense returns an empty list when the ID is invalid, or was deleted upstream
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, errs.NewNotFoundError()
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(respJson[opts.Monad], resource); err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
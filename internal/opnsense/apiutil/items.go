@@ -0,0 +1,27 @@
+package apiutil
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Items holds the items of an OPNsense array field (e.g. all filter rules,
+// as returned by a settings get endpoint), keyed by UUID. OPNsense returns an
+// empty array field as an empty JSON array instead of an object, which is
+// decoded into an empty map.
+type Items[K any] map[string]K
+
+func (i *Items[K]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("[]")) {
+		*i = Items[K]{}
+		return nil
+	}
+
+	items := map[string]K{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	*i = items
+	return nil
+}
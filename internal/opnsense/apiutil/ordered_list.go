@@ -0,0 +1,86 @@
+package apiutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+/*
+	OrderedList is like api.SelectedMapList, but keeps the order of the selected
+	keys as returned by OPNsense, instead of sorting them. This matters for
+	fields where the order is meaningful, e.g. a list of DNS servers.
+
+	Some fields are returned as a plain, comma separated, string instead of a
+	map, both are supported.
+*/
+
+type OrderedList []string
+
+func (s *OrderedList) UnmarshalJSON(data []byte) error {
+	// Handle plain strings
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*s = splitNonEmpty(str, ",")
+		return nil
+	}
+
+	// Walk map keys in order, since json.Unmarshal into a map would lose it
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	list := OrderedList{}
+	for dec.More() {
+		keyToken, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		var option struct {
+			Value    string `json:"value"`
+			Selected any    `json:"selected"`
+		}
+		if err := dec.Decode(&option); err != nil {
+			return err
+		}
+
+		if isSelected(option.Selected) {
+			list = append(list, keyToken.(string))
+		}
+	}
+
+	*s = list
+	return nil
+}
+
+func (s *OrderedList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.Join(*s, ","))
+}
+
+func (s *OrderedList) String() string {
+	return strings.Join(*s, ",")
+}
+
+// Helpers
+
+func isSelected(selected any) bool {
+	switch v := selected.(type) {
+	case bool:
+		return v
+	case float64:
+		return v == 1
+	}
+	return false
+}
+
+func splitNonEmpty(s string, sep string) []string {
+	list := []string{}
+	for _, i := range strings.Split(s, sep) {
+		if i != "" {
+			list = append(list, i)
+		}
+	}
+	return list
+}
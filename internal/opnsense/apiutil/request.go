@@ -0,0 +1,97 @@
+package apiutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/go-retryablehttp"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+/*
+	The api package does not export its request function, so endpoints that
+	are not CRUD operations (e.g. actions returning generated keys) cannot be
+	called through it. Do sends such requests with the options the api.Client
+	was created with, which are registered by the provider.
+*/
+
+type requester struct {
+	client *retryablehttp.Client
+	opts   api.Options
+}
+
+var requesters sync.Map
+
+// RegisterClient records the options c was created with, so Do can send
+// requests on its behalf.
+func RegisterClient(c *api.Client, opts api.Options) {
+	client := retryablehttp.NewClient()
+	client.Logger = nil
+	client.HTTPClient.Transport = &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: opts.AllowInsecure},
+	}
+
+	// Match the retry behaviour of the api.Client
+	if opts.MaxBackoff != 0 {
+		client.RetryWaitMax = time.Duration(opts.MaxBackoff) * time.Second
+	}
+	if opts.MinBackoff != 0 {
+		client.RetryWaitMin = time.Duration(opts.MinBackoff) * time.Second
+	}
+	if opts.MaxRetries != 0 {
+		client.RetryMax = int(opts.MaxRetries)
+	}
+
+	requesters.Store(c, &requester{client: client, opts: opts})
+}
+
+// Do sends a request to endpoint (e.g. `/wireguard/server/keyPair`) and
+// decodes the JSON response into resp. Request and response bodies are not
+// logged, since they may contain secrets.
+func Do(c *api.Client, ctx context.Context, method string, endpoint string, body any, resp any) error {
+	v, ok := requesters.Load(c)
+	if !ok {
+		return fmt.Errorf("no options registered for client, unable to request %s", endpoint)
+	}
+	r := v.(*requester)
+
+	// Marshal body, if any
+	var bodyReader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/api%s", r.opts.Uri, endpoint), bodyReader)
+	if err != nil {
+		return err
+	}
+
+	auth := base64.StdEncoding.EncodeToString([]byte(r.opts.APIKey + ":" + r.opts.APISecret))
+	req.Header.Add("Authorization", fmt.Sprintf("Basic %s", auth))
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("status code non-200; status code %d", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(resp)
+}
@@ -0,0 +1,44 @@
+package apiutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+/*
+	Scalar is a string that can also be unmarshalled from a JSON number,
+	boolean or null. Status endpoints are not consistent in the JSON types
+	they return, e.g. byte counters are returned as either numbers or strings.
+	Booleans are converted to "1" or "0", like OPNsense does for settings.
+*/
+
+type Scalar string
+
+func (s *Scalar) UnmarshalJSON(data []byte) error {
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*s = ""
+	case bytes.Equal(data, []byte("true")):
+		*s = "1"
+	case bytes.Equal(data, []byte("false")):
+		*s = "0"
+	case len(data) > 0 && data[0] == '"':
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		*s = Scalar(str)
+	default:
+		var num json.Number
+		if err := json.Unmarshal(data, &num); err != nil {
+			return fmt.Errorf("value is not a scalar: %s", data)
+		}
+		*s = Scalar(num.String())
+	}
+	return nil
+}
+
+func (s Scalar) String() string {
+	return string(s)
+}
@@ -0,0 +1,26 @@
+// Package apiutil contains helpers for OPNsense API endpoints that do not fit
+// the CRUD operations provided by the opnsense-go api package.
+package apiutil
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+// Search returns all rows of an OPNsense search endpoint (e.g.
+// `/unbound/settings/searchHostOverride`). Rows contain the display values
+// of each field, so selected options are returned as their description.
+func Search[K any](c *api.Client, ctx context.Context, endpoint string) ([]K, error) {
+	opts := api.ReqOpts{
+		GetEndpoint: endpoint,
+		Monad:       "rows",
+	}
+
+	// Request all rows, instead of the first page
+	rows, err := api.Get(c, ctx, opts, &[]K{}, "?rowCount=-1")
+	if err != nil {
+		return nil, err
+	}
+
+	return *rows, nil
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const (
+	apiKeyAddEndpoint    = "/auth/user/addApiKey"
+	apiKeySearchEndpoint = "/auth/user/searchApiKey"
+	apiKeyDeleteEndpoint = "/auth/user/delApiKey"
+)
+
+// Data structs
+
+// APIKey is an API key of a user, as returned by the search endpoint. The
+// secret is only returned when the key is created.
+type APIKey struct {
+	Id       string `json:"id"`
+	Key      string `json:"key"`
+	Username string `json:"username"`
+}
+
+// API key operations
+
+// AddAPIKey generates a new API key for the user, returning the key and its
+// secret.
+func (c *Controller) AddAPIKey(ctx context.Context, username string) (string, string, error) {
+	respJson := &struct {
+		Result string `json:"result"`
+		Key    string `json:"key"`
+		Secret string `json:"secret"`
+	}{}
+
+	err := apiutil.Do(c.Client(), ctx, "POST", fmt.Sprintf("%s/%s", apiKeyAddEndpoint, username), map[string]string{}, respJson)
+	if err != nil {
+		return "", "", err
+	}
+
+	if respJson.Result != "ok" || respJson.Key == "" {
+		return "", "", fmt.Errorf("api key not created. result: %s", respJson.Result)
+	}
+
+	return respJson.Key, respJson.Secret, nil
+}
+
+// SearchAPIKeys returns the API keys of all users.
+func (c *Controller) SearchAPIKeys(ctx context.Context) ([]APIKey, error) {
+	return apiutil.Search[APIKey](c.Client(), ctx, apiKeySearchEndpoint)
+}
+
+// DeleteAPIKey deletes an API key, by its key.
+func (c *Controller) DeleteAPIKey(ctx context.Context, key string) error {
+	respJson := &struct {
+		Result string `json:"result"`
+	}{}
+
+	err := apiutil.Do(c.Client(), ctx, "POST", fmt.Sprintf("%s/%s", apiKeyDeleteEndpoint, key), map[string]string{}, respJson)
+	if err != nil {
+		return err
+	}
+
+	if respJson.Result != "deleted" {
+		return fmt.Errorf("api key not deleted. result: %s", respJson.Result)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package auth
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+// Controller for auth
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
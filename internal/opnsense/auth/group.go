@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var GroupOpts = api.ReqOpts{
+	AddEndpoint:    "/auth/group/add",
+	GetEndpoint:    "/auth/group/get",
+	UpdateEndpoint: "/auth/group/set",
+	DeleteEndpoint: "/auth/group/del",
+	Monad:          "group",
+}
+
+// Data structs
+
+type Group struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Privileges  api.SelectedMapList `json:"priv"`
+	GID         string              `json:"gid,omitempty"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddGroup(ctx context.Context, resource *Group) (string, error) {
+	return api.Add(c.Client(), ctx, GroupOpts, resource)
+}
+
+func (c *Controller) GetGroup(ctx context.Context, id string) (*Group, error) {
+	return api.Get(c.Client(), ctx, GroupOpts, &Group{}, id)
+}
+
+func (c *Controller) UpdateGroup(ctx context.Context, id string, resource *Group) error {
+	return api.Update(c.Client(), ctx, GroupOpts, resource, id)
+}
+
+func (c *Controller) DeleteGroup(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, GroupOpts, id)
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var UserOpts = api.ReqOpts{
+	AddEndpoint:    "/auth/user/add",
+	GetEndpoint:    "/auth/user/get",
+	UpdateEndpoint: "/auth/user/set",
+	DeleteEndpoint: "/auth/user/del",
+	Monad:          "user",
+}
+
+// Data structs
+
+type User struct {
+	Disabled          string              `json:"disabled"`
+	Name              string              `json:"name"`
+	FullName          string              `json:"descr"`
+	Email             string              `json:"email"`
+	Comment           string              `json:"comment"`
+	Password          string              `json:"password"`
+	ScrambledPassword string              `json:"scrambled_password"`
+	Expires           string              `json:"expires"`
+	Shell             api.SelectedMap     `json:"shell"`
+	AuthorizedKeys    string              `json:"authorizedkeys"`
+	Groups            api.SelectedMapList `json:"group_memberships"`
+	UID               string              `json:"uid,omitempty"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the password is not logged.
+
+func (c *Controller) AddUser(ctx context.Context, resource *User) (string, error) {
+	return apiutil.Add(c.Client(), ctx, UserOpts, resource)
+}
+
+func (c *Controller) GetUser(ctx context.Context, id string) (*User, error) {
+	return apiutil.Get(c.Client(), ctx, UserOpts, &User{}, id)
+}
+
+func (c *Controller) UpdateUser(ctx context.Context, id string, resource *User) error {
+	return apiutil.Update(c.Client(), ctx, UserOpts, resource, id)
+}
+
+func (c *Controller) DeleteUser(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, UserOpts, id)
+}
@@ -0,0 +1,113 @@
+// Package opnsense extends the opnsense-go client with controllers for
+// OPNsense APIs that are not (yet) supported upstream.
+package opnsense
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	upstreamfirewall "github.com/browningluke/opnsense-go/pkg/firewall"
+	upstreamroutes "github.com/browningluke/opnsense-go/pkg/routes"
+	upstream "github.com/browningluke/opnsense-go/pkg/unbound"
+	"terraform-provider-opnsense/internal/opnsense/acme"
+	"terraform-provider-opnsense/internal/opnsense/auth"
+	"terraform-provider-opnsense/internal/opnsense/cron"
+	"terraform-provider-opnsense/internal/opnsense/dhcpv4"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/opnsense/ipsec"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/opnsense/openvpn"
+	"terraform-provider-opnsense/internal/opnsense/routes"
+	"terraform-provider-opnsense/internal/opnsense/syslog"
+	"terraform-provider-opnsense/internal/opnsense/trafficshaper"
+	"terraform-provider-opnsense/internal/opnsense/trust"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/opnsense/wireguard"
+)
+
+// Client defines a client interface for the OPNsense API.
+type Client interface {
+	Unbound() *unbound.Controller
+	Kea() *kea.Controller
+	Dhcpv4() *dhcpv4.Controller
+	Dnsmasq() *dnsmasq.Controller
+	Wireguard() *wireguard.Controller
+	OpenVPN() *openvpn.Controller
+	IPsec() *ipsec.Controller
+	Trust() *trust.Controller
+	Acme() *acme.Controller
+	Auth() *auth.Controller
+	Cron() *cron.Controller
+	Syslog() *syslog.Controller
+	TrafficShaper() *trafficshaper.Controller
+	Firewall() *firewall.Controller
+	Routes() *routes.Controller
+}
+
+type client struct {
+	a *api.Client
+}
+
+// NewClient creates a new API client.
+func NewClient(a *api.Client) Client {
+	return &client{a: a}
+}
+
+func (c *client) Unbound() *unbound.Controller {
+	return &unbound.Controller{Controller: upstream.Controller{Api: c.a}}
+}
+
+func (c *client) Kea() *kea.Controller {
+	return &kea.Controller{Api: c.a}
+}
+
+func (c *client) Dhcpv4() *dhcpv4.Controller {
+	return &dhcpv4.Controller{Api: c.a}
+}
+
+func (c *client) Dnsmasq() *dnsmasq.Controller {
+	return &dnsmasq.Controller{Api: c.a}
+}
+
+func (c *client) Wireguard() *wireguard.Controller {
+	return &wireguard.Controller{Api: c.a}
+}
+
+func (c *client) OpenVPN() *openvpn.Controller {
+	return &openvpn.Controller{Api: c.a}
+}
+
+func (c *client) IPsec() *ipsec.Controller {
+	return &ipsec.Controller{Api: c.a}
+}
+
+func (c *client) Trust() *trust.Controller {
+	return &trust.Controller{Api: c.a}
+}
+
+func (c *client) Acme() *acme.Controller {
+	return &acme.Controller{Api: c.a}
+}
+
+func (c *client) Auth() *auth.Controller {
+	return &auth.Controller{Api: c.a}
+}
+
+func (c *client) Cron() *cron.Controller {
+	return &cron.Controller{Api: c.a}
+}
+
+func (c *client) Syslog() *syslog.Controller {
+	return &syslog.Controller{Api: c.a}
+}
+
+func (c *client) TrafficShaper() *trafficshaper.Controller {
+	return &trafficshaper.Controller{Api: c.a}
+}
+
+func (c *client) Firewall() *firewall.Controller {
+	return &firewall.Controller{Controller: upstreamfirewall.Controller{Api: c.a}}
+}
+
+func (c *client) Routes() *routes.Controller {
+	return &routes.Controller{Controller: upstreamroutes.Controller{Api: c.a}}
+}
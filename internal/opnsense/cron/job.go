@@ -0,0 +1,77 @@
+package cron
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var JobOpts = api.ReqOpts{
+	AddEndpoint:         "/cron/settings/addJob",
+	GetEndpoint:         "/cron/settings/getJob",
+	UpdateEndpoint:      "/cron/settings/setJob",
+	DeleteEndpoint:      "/cron/settings/delJob",
+	ReconfigureEndpoint: cronReconfigureEndpoint,
+	Monad:               "job",
+}
+
+// Data structs
+
+type Job struct {
+	Enabled     string          `json:"enabled"`
+	Minutes     string          `json:"minutes"`
+	Hours       string          `json:"hours"`
+	Days        string          `json:"days"`
+	Months      string          `json:"months"`
+	Weekdays    string          `json:"weekdays"`
+	Command     api.SelectedMap `json:"command"`
+	Parameters  string          `json:"parameters"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddJob(ctx context.Context, resource *Job) (string, error) {
+	return api.Add(c.Client(), ctx, JobOpts, resource)
+}
+
+func (c *Controller) GetJob(ctx context.Context, id string) (*Job, error) {
+	return api.Get(c.Client(), ctx, JobOpts, &Job{}, id)
+}
+
+func (c *Controller) UpdateJob(ctx context.Context, id string, resource *Job) error {
+	return api.Update(c.Client(), ctx, JobOpts, resource, id)
+}
+
+func (c *Controller) DeleteJob(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, JobOpts, id)
+}
+
+// Command operations
+
+// ListCommands returns the configd actions that can be scheduled, as
+// offered by the command field of a new job.
+func (c *Controller) ListCommands(ctx context.Context) ([]string, error) {
+	respJson := &struct {
+		Job struct {
+			Command map[string]json.RawMessage `json:"command"`
+		} `json:"job"`
+	}{}
+
+	err := apiutil.Do(c.Client(), ctx, "GET", JobOpts.GetEndpoint, nil, respJson)
+	if err != nil {
+		return nil, err
+	}
+
+	var commands []string
+	for command := range respJson.Job.Command {
+		if command != "" {
+			commands = append(commands, command)
+		}
+	}
+	sort.Strings(commands)
+
+	return commands, nil
+}
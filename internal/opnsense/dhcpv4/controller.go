@@ -0,0 +1,14 @@
+package dhcpv4
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+// Controller for the ISC DHCPv4 server
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
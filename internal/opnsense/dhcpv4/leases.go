@@ -0,0 +1,33 @@
+package dhcpv4
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const leaseSearchEndpoint = "/dhcpv4/leases/searchLease"
+
+// Data structs
+
+// Lease is a lease of the ISC DHCPv4 server as returned by the search
+// endpoint. Static mappings are returned as leases with type `static`.
+type Lease struct {
+	Address              string `json:"address"`
+	MAC                  string `json:"mac"`
+	Hostname             string `json:"hostname"`
+	ClientHostname       string `json:"client-hostname"`
+	Interface            string `json:"if"`
+	InterfaceDescription string `json:"if_descr"`
+	Type                 string `json:"type"`
+	Status               string `json:"status"`
+	State                string `json:"state"`
+	Starts               string `json:"starts"`
+	Ends                 string `json:"ends"`
+	Description          string `json:"descr"`
+}
+
+// Search operations
+
+func (c *Controller) SearchLeases(ctx context.Context) ([]Lease, error) {
+	return apiutil.Search[Lease](c.Client(), ctx, leaseSearchEndpoint)
+}
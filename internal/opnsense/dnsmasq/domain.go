@@ -0,0 +1,42 @@
+package dnsmasq
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var DomainOpts = api.ReqOpts{
+	AddEndpoint:         "/dnsmasq/settings/addDomain",
+	GetEndpoint:         "/dnsmasq/settings/getDomain",
+	UpdateEndpoint:      "/dnsmasq/settings/setDomain",
+	DeleteEndpoint:      "/dnsmasq/settings/delDomain",
+	ReconfigureEndpoint: dnsmasqReconfigureEndpoint,
+	Monad:               "domainoverride",
+}
+
+// Data structs
+
+type Domain struct {
+	Domain      string `json:"domain"`
+	IP          string `json:"ip"`
+	Port        string `json:"port"`
+	Description string `json:"descr"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDomain(ctx context.Context, resource *Domain) (string, error) {
+	return api.Add(c.Client(), ctx, DomainOpts, resource)
+}
+
+func (c *Controller) GetDomain(ctx context.Context, id string) (*Domain, error) {
+	return api.Get(c.Client(), ctx, DomainOpts, &Domain{}, id)
+}
+
+func (c *Controller) UpdateDomain(ctx context.Context, id string, resource *Domain) error {
+	return api.Update(c.Client(), ctx, DomainOpts, resource, id)
+}
+
+func (c *Controller) DeleteDomain(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, DomainOpts, id)
+}
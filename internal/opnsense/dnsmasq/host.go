@@ -0,0 +1,48 @@
+package dnsmasq
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var HostOpts = api.ReqOpts{
+	AddEndpoint:         "/dnsmasq/settings/addHost",
+	GetEndpoint:         "/dnsmasq/settings/getHost",
+	UpdateEndpoint:      "/dnsmasq/settings/setHost",
+	DeleteEndpoint:      "/dnsmasq/settings/delHost",
+	ReconfigureEndpoint: dnsmasqReconfigureEndpoint,
+	Monad:               "host",
+}
+
+// Data structs
+
+// Host is a host override. Hosts with hardware addresses are also used as
+// static DHCP hosts.
+type Host struct {
+	Host        string              `json:"host"`
+	Domain      string              `json:"domain"`
+	IP          apiutil.OrderedList `json:"ip"`
+	HWAddr      apiutil.OrderedList `json:"hwaddr"`
+	ClientId    string              `json:"client_id"`
+	LeaseTime   string              `json:"lease_time"`
+	Description string              `json:"descr"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddHost(ctx context.Context, resource *Host) (string, error) {
+	return api.Add(c.Client(), ctx, HostOpts, resource)
+}
+
+func (c *Controller) GetHost(ctx context.Context, id string) (*Host, error) {
+	return api.Get(c.Client(), ctx, HostOpts, &Host{}, id)
+}
+
+func (c *Controller) UpdateHost(ctx context.Context, id string, resource *Host) error {
+	return api.Update(c.Client(), ctx, HostOpts, resource, id)
+}
+
+func (c *Controller) DeleteHost(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, HostOpts, id)
+}
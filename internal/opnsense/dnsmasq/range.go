@@ -0,0 +1,45 @@
+package dnsmasq
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var RangeOpts = api.ReqOpts{
+	AddEndpoint:         "/dnsmasq/settings/addRange",
+	GetEndpoint:         "/dnsmasq/settings/getRange",
+	UpdateEndpoint:      "/dnsmasq/settings/setRange",
+	DeleteEndpoint:      "/dnsmasq/settings/delRange",
+	ReconfigureEndpoint: dnsmasqReconfigureEndpoint,
+	Monad:               "range",
+}
+
+// Data structs
+
+type Range struct {
+	Interface   api.SelectedMap `json:"interface"`
+	StartAddr   string          `json:"start_addr"`
+	EndAddr     string          `json:"end_addr"`
+	SubnetMask  string          `json:"subnet_mask"`
+	Domain      string          `json:"domain"`
+	LeaseTime   string          `json:"lease_time"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddRange(ctx context.Context, resource *Range) (string, error) {
+	return api.Add(c.Client(), ctx, RangeOpts, resource)
+}
+
+func (c *Controller) GetRange(ctx context.Context, id string) (*Range, error) {
+	return api.Get(c.Client(), ctx, RangeOpts, &Range{}, id)
+}
+
+func (c *Controller) UpdateRange(ctx context.Context, id string, resource *Range) error {
+	return api.Update(c.Client(), ctx, RangeOpts, resource, id)
+}
+
+func (c *Controller) DeleteRange(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, RangeOpts, id)
+}
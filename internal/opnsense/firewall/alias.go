@@ -0,0 +1,65 @@
+package firewall
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const aliasSearchEndpoint = "/firewall/alias/searchItem"
+
+// Data structs
+
+// AliasRow is an alias as returned by the search endpoint. The type is only
+// returned as its description (e.g. `Host(s)`).
+type AliasRow struct {
+	Id          string `json:"uuid"`
+	Enabled     string `json:"enabled"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// AliasSettings is an alias without its content. OPNsense only changes the
+// fields that are sent, so updating an alias with it leaves the content in
+// place, which avoids sending large aliases again when only their settings
+// change.
+type AliasSettings struct {
+	Enabled     string              `json:"enabled"`
+	Name        string              `json:"name"`
+	Type        api.SelectedMap     `json:"type"`
+	IPProtocol  api.SelectedMap     `json:"proto"`
+	Interface   api.SelectedMap     `json:"interface"`
+	Categories  api.SelectedMapList `json:"categories"`
+	UpdateFreq  string              `json:"updatefreq"`
+	Statistics  string              `json:"counters"`
+	Description string              `json:"description"`
+}
+
+// NewAliasSettings returns the settings of the alias.
+func NewAliasSettings(a *firewall.Alias) *AliasSettings {
+	return &AliasSettings{
+		Enabled:     a.Enabled,
+		Name:        a.Name,
+		Type:        a.Type,
+		IPProtocol:  a.IPProtocol,
+		Interface:   a.Interface,
+		Categories:  a.Categories,
+		UpdateFreq:  a.UpdateFreq,
+		Statistics:  a.Statistics,
+		Description: a.Description,
+	}
+}
+
+// CRUD operations
+
+func (c *Controller) UpdateAliasSettings(ctx context.Context, id string, resource *AliasSettings) error {
+	return api.Update(c.Client(), ctx, firewall.AliasOpts, resource, id)
+}
+
+// Search operations
+
+func (c *Controller) SearchAliases(ctx context.Context) ([]AliasRow, error) {
+	return apiutil.Search[AliasRow](c.Client(), ctx, aliasSearchEndpoint)
+}
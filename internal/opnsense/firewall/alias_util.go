@@ -0,0 +1,74 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const (
+	aliasUtilListEndpoint   = "/firewall/alias_util/list"
+	aliasUtilAddEndpoint    = "/firewall/alias_util/add"
+	aliasUtilDeleteEndpoint = "/firewall/alias_util/delete"
+)
+
+// Data structs
+
+// AliasEntry is an address in the pf table of an alias, as returned by the
+// list endpoint.
+type AliasEntry struct {
+	Address string `json:"ip"`
+}
+
+// Alias table operations
+
+// ListAliasEntries returns the current contents of the pf table of an alias,
+// by name. Unlike the alias content, it includes the addresses resolved at
+// runtime (e.g. of urltable or geoip aliases).
+func (c *Controller) ListAliasEntries(ctx context.Context, alias string) ([]string, error) {
+	respJson := &struct {
+		Rows []AliasEntry `json:"rows"`
+	}{}
+
+	// Request all rows, instead of the first page
+	err := apiutil.Do(c.Client(), ctx, "GET", fmt.Sprintf("%s/%s?rowCount=-1", aliasUtilListEndpoint, alias), nil, respJson)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]string, 0, len(respJson.Rows))
+	for _, row := range respJson.Rows {
+		entries = append(entries, row.Address)
+	}
+	return entries, nil
+}
+
+// AddAliasEntry adds an address to the pf table of an alias, by name. The
+// address is not stored in the configuration, so it only persists for
+// external aliases.
+func (c *Controller) AddAliasEntry(ctx context.Context, alias string, address string) error {
+	return c.updateAliasEntry(ctx, aliasUtilAddEndpoint, alias, address)
+}
+
+// DeleteAliasEntry removes an address from the pf table of an alias, by name.
+func (c *Controller) DeleteAliasEntry(ctx context.Context, alias string, address string) error {
+	return c.updateAliasEntry(ctx, aliasUtilDeleteEndpoint, alias, address)
+}
+
+func (c *Controller) updateAliasEntry(ctx context.Context, endpoint string, alias string, address string) error {
+	respJson := &struct {
+		Status string `json:"status"`
+	}{}
+
+	body := map[string]string{"address": address}
+	err := apiutil.Do(c.Client(), ctx, "POST", fmt.Sprintf("%s/%s", endpoint, alias), body, respJson)
+	if err != nil {
+		return err
+	}
+
+	if respJson.Status != "done" {
+		return fmt.Errorf("alias table not updated. status: %s", respJson.Status)
+	}
+
+	return nil
+}
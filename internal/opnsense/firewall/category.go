@@ -0,0 +1,23 @@
+package firewall
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const categorySearchEndpoint = "/firewall/category/searchItem"
+
+// Data structs
+
+// CategoryRow is a category as returned by the search endpoint.
+type CategoryRow struct {
+	Id    string `json:"uuid"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Search operations
+
+func (c *Controller) SearchCategories(ctx context.Context) ([]CategoryRow, error) {
+	return apiutil.Search[CategoryRow](c.Client(), ctx, categorySearchEndpoint)
+}
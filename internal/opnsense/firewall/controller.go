@@ -0,0 +1,12 @@
+package firewall
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/firewall"
+)
+
+const firewallReconfigureEndpoint = "/firewall/filter/apply"
+
+// Controller for firewall, extends the upstream opnsense-go controller
+type Controller struct {
+	firewall.Controller
+}
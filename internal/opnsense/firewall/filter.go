@@ -0,0 +1,135 @@
+package firewall
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const filterGetEndpoint = "/firewall/filter/get"
+
+var FilterOpts = api.ReqOpts{
+	AddEndpoint:         "/firewall/filter/addRule",
+	GetEndpoint:         "/firewall/filter/getRule",
+	UpdateEndpoint:      "/firewall/filter/setRule",
+	DeleteEndpoint:      "/firewall/filter/delRule",
+	ReconfigureEndpoint: firewallReconfigureEndpoint,
+	Monad:               "rule",
+}
+
+// filterStagedOpts do not apply the filter after each change, so that many
+// rules can be changed before they are applied at once (see ApplyFilters).
+var filterStagedOpts = api.ReqOpts{
+	AddEndpoint:    FilterOpts.AddEndpoint,
+	GetEndpoint:    FilterOpts.GetEndpoint,
+	UpdateEndpoint: FilterOpts.UpdateEndpoint,
+	DeleteEndpoint: FilterOpts.DeleteEndpoint,
+	Monad:          FilterOpts.Monad,
+}
+
+// Data structs
+
+// Filter replaces the upstream filter rule, which lacks the fields added
+// since.
+type Filter struct {
+	Enabled           string              `json:"enabled"`
+	Sequence          string              `json:"sequence"`
+	Action            api.SelectedMap     `json:"action"`
+	Quick             string              `json:"quick"`
+	Interface         api.SelectedMapList `json:"interface"`
+	Direction         api.SelectedMap     `json:"direction"`
+	IPProtocol        api.SelectedMap     `json:"ipprotocol"`
+	Protocol          api.SelectedMap     `json:"protocol"`
+	SourceNet         string              `json:"source_net"`
+	SourcePort        string              `json:"source_port"`
+	SourceInvert      string              `json:"source_not"`
+	DestinationNet    string              `json:"destination_net"`
+	DestinationPort   string              `json:"destination_port"`
+	DestinationInvert string              `json:"destination_not"`
+	Gateway           api.SelectedMap     `json:"gateway"`
+	Schedule          api.SelectedMap     `json:"sched"`
+	Log               string              `json:"log"`
+	Description       string              `json:"description"`
+
+	// Advanced options
+	StateType             api.SelectedMap     `json:"statetype"`
+	StateTimeout          string              `json:"statetimeout"`
+	MaxStates             string              `json:"max"`
+	MaxSourceNodes        string              `json:"max-src-nodes"`
+	MaxSourceStates       string              `json:"max-src-states"`
+	MaxSourceConnections  string              `json:"max-src-conn"`
+	MaxSourceConnRate     string              `json:"max-src-conn-rate"`
+	MaxSourceConnRateSecs string              `json:"max-src-conn-rates"`
+	TCPFlags              api.SelectedMapList `json:"tcpflags1"`
+	TCPFlagsOutOf         api.SelectedMapList `json:"tcpflags2"`
+	ICMPTypes             api.SelectedMapList `json:"icmptype"`
+	ICMPv6Types           api.SelectedMapList `json:"icmp6type"`
+	Tag                   string              `json:"tag"`
+	Tagged                string              `json:"tagged"`
+	AllowOptions          string              `json:"allowopts"`
+	NoSync                string              `json:"nosync"`
+	Categories            api.SelectedMapList `json:"categories"`
+	ReplyTo               api.SelectedMap     `json:"replyto"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddFilter(ctx context.Context, resource *Filter) (string, error) {
+	return api.Add(c.Client(), ctx, FilterOpts, resource)
+}
+
+func (c *Controller) GetFilter(ctx context.Context, id string) (*Filter, error) {
+	return api.Get(c.Client(), ctx, FilterOpts, &Filter{}, id)
+}
+
+func (c *Controller) UpdateFilter(ctx context.Context, id string, resource *Filter) error {
+	return api.Update(c.Client(), ctx, FilterOpts, resource, id)
+}
+
+func (c *Controller) DeleteFilter(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, FilterOpts, id)
+}
+
+// Staged operations
+
+// AddFilterStaged creates a filter rule without applying the filter.
+func (c *Controller) AddFilterStaged(ctx context.Context, resource *Filter) (string, error) {
+	return api.Add(c.Client(), ctx, filterStagedOpts, resource)
+}
+
+// UpdateFilterStaged updates a filter rule without applying the filter.
+func (c *Controller) UpdateFilterStaged(ctx context.Context, id string, resource *Filter) error {
+	return api.Update(c.Client(), ctx, filterStagedOpts, resource, id)
+}
+
+// DeleteFilterStaged deletes a filter rule without applying the filter.
+func (c *Controller) DeleteFilterStaged(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, filterStagedOpts, id)
+}
+
+// ApplyFilters applies the saved filter rules, including the staged changes.
+func (c *Controller) ApplyFilters(ctx context.Context) error {
+	return c.Client().ReconfigureService(ctx, firewallReconfigureEndpoint)
+}
+
+// List operations
+
+// ListFilters returns all filter rules, keyed by UUID. The settings endpoint
+// is used over the search endpoint, since the latter only returns the display
+// values of the option fields (e.g. interface descriptions).
+func (c *Controller) ListFilters(ctx context.Context) (map[string]Filter, error) {
+	respJson := &struct {
+		Filter struct {
+			Rules struct {
+				Rule apiutil.Items[Filter] `json:"rule"`
+			} `json:"rules"`
+		} `json:"filter"`
+	}{}
+
+	err := apiutil.Do(c.Client(), ctx, "GET", filterGetEndpoint, nil, respJson)
+	if err != nil {
+		return nil, err
+	}
+
+	return respJson.Filter.Rules.Rule, nil
+}
@@ -0,0 +1,53 @@
+package ipsec
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var ChildOpts = api.ReqOpts{
+	AddEndpoint:         "/ipsec/connections/addChild",
+	GetEndpoint:         "/ipsec/connections/getChild",
+	UpdateEndpoint:      "/ipsec/connections/setChild",
+	DeleteEndpoint:      "/ipsec/connections/delChild",
+	ReconfigureEndpoint: ipsecReconfigureEndpoint,
+	Monad:               "child",
+}
+
+// Data structs
+
+type Child struct {
+	Enabled      string              `json:"enabled"`
+	Connection   api.SelectedMap     `json:"connection"`
+	ReqId        string              `json:"reqid"`
+	ESPProposals api.SelectedMapList `json:"esp_proposals"`
+	SHA256_96    string              `json:"sha256_96"`
+	StartAction  api.SelectedMap     `json:"start_action"`
+	CloseAction  api.SelectedMap     `json:"close_action"`
+	DPDAction    api.SelectedMap     `json:"dpd_action"`
+	Mode         api.SelectedMap     `json:"mode"`
+	Policies     string              `json:"policies"`
+	LocalTS      apiutil.OrderedList `json:"local_ts"`
+	RemoteTS     apiutil.OrderedList `json:"remote_ts"`
+	RekeyTime    string              `json:"rekey_time"`
+	Description  string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddChild(ctx context.Context, resource *Child) (string, error) {
+	return api.Add(c.Client(), ctx, ChildOpts, resource)
+}
+
+func (c *Controller) GetChild(ctx context.Context, id string) (*Child, error) {
+	return api.Get(c.Client(), ctx, ChildOpts, &Child{}, id)
+}
+
+func (c *Controller) UpdateChild(ctx context.Context, id string, resource *Child) error {
+	return api.Update(c.Client(), ctx, ChildOpts, resource, id)
+}
+
+func (c *Controller) DeleteChild(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, ChildOpts, id)
+}
@@ -0,0 +1,60 @@
+package ipsec
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var ConnectionOpts = api.ReqOpts{
+	AddEndpoint:         "/ipsec/connections/addConnection",
+	GetEndpoint:         "/ipsec/connections/getConnection",
+	UpdateEndpoint:      "/ipsec/connections/setConnection",
+	DeleteEndpoint:      "/ipsec/connections/delConnection",
+	ReconfigureEndpoint: ipsecReconfigureEndpoint,
+	Monad:               "connection",
+}
+
+// Data structs
+
+type Connection struct {
+	Enabled     string              `json:"enabled"`
+	Proposals   api.SelectedMapList `json:"proposals"`
+	Unique      api.SelectedMap     `json:"unique"`
+	Aggressive  string              `json:"aggressive"`
+	Version     api.SelectedMap     `json:"version"`
+	Mobike      string              `json:"mobike"`
+	LocalAddrs  apiutil.OrderedList `json:"local_addrs"`
+	LocalPort   string              `json:"local_port"`
+	RemoteAddrs apiutil.OrderedList `json:"remote_addrs"`
+	RemotePort  string              `json:"remote_port"`
+	Encap       string              `json:"encap"`
+	ReauthTime  string              `json:"reauth_time"`
+	RekeyTime   string              `json:"rekey_time"`
+	OverTime    string              `json:"over_time"`
+	DPDDelay    string              `json:"dpd_delay"`
+	DPDTimeout  string              `json:"dpd_timeout"`
+	Pools       api.SelectedMapList `json:"pools"`
+	SendCertReq string              `json:"send_certreq"`
+	SendCert    api.SelectedMap     `json:"send_cert"`
+	KeyingTries string              `json:"keyingtries"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddConnection(ctx context.Context, resource *Connection) (string, error) {
+	return api.Add(c.Client(), ctx, ConnectionOpts, resource)
+}
+
+func (c *Controller) GetConnection(ctx context.Context, id string) (*Connection, error) {
+	return api.Get(c.Client(), ctx, ConnectionOpts, &Connection{}, id)
+}
+
+func (c *Controller) UpdateConnection(ctx context.Context, id string, resource *Connection) error {
+	return api.Update(c.Client(), ctx, ConnectionOpts, resource, id)
+}
+
+func (c *Controller) DeleteConnection(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, ConnectionOpts, id)
+}
@@ -0,0 +1,16 @@
+package ipsec
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+const ipsecReconfigureEndpoint = "/ipsec/service/reconfigure"
+
+// Controller for ipsec
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
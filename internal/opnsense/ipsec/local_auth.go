@@ -0,0 +1,49 @@
+package ipsec
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var LocalAuthOpts = api.ReqOpts{
+	AddEndpoint:         "/ipsec/connections/addLocal",
+	GetEndpoint:         "/ipsec/connections/getLocal",
+	UpdateEndpoint:      "/ipsec/connections/setLocal",
+	DeleteEndpoint:      "/ipsec/connections/delLocal",
+	ReconfigureEndpoint: ipsecReconfigureEndpoint,
+	Monad:               "local",
+}
+
+// Data structs
+
+// LocalAuth is the local authentication of a connection, called local in
+// the OPNsense API.
+type LocalAuth struct {
+	Enabled     string              `json:"enabled"`
+	Connection  api.SelectedMap     `json:"connection"`
+	Round       string              `json:"round"`
+	Auth        api.SelectedMap     `json:"auth"`
+	Id          string              `json:"id"`
+	EAPId       string              `json:"eap_id"`
+	Certs       api.SelectedMapList `json:"certs"`
+	PubKeys     api.SelectedMapList `json:"pubkeys"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddLocalAuth(ctx context.Context, resource *LocalAuth) (string, error) {
+	return api.Add(c.Client(), ctx, LocalAuthOpts, resource)
+}
+
+func (c *Controller) GetLocalAuth(ctx context.Context, id string) (*LocalAuth, error) {
+	return api.Get(c.Client(), ctx, LocalAuthOpts, &LocalAuth{}, id)
+}
+
+func (c *Controller) UpdateLocalAuth(ctx context.Context, id string, resource *LocalAuth) error {
+	return api.Update(c.Client(), ctx, LocalAuthOpts, resource, id)
+}
+
+func (c *Controller) DeleteLocalAuth(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, LocalAuthOpts, id)
+}
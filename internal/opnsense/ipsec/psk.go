@@ -0,0 +1,46 @@
+package ipsec
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var PSKOpts = api.ReqOpts{
+	AddEndpoint:         "/ipsec/pre_shared_keys/addItem",
+	GetEndpoint:         "/ipsec/pre_shared_keys/getItem",
+	UpdateEndpoint:      "/ipsec/pre_shared_keys/setItem",
+	DeleteEndpoint:      "/ipsec/pre_shared_keys/delItem",
+	ReconfigureEndpoint: ipsecReconfigureEndpoint,
+	Monad:               "preSharedKey",
+}
+
+// Data structs
+
+type PSK struct {
+	Identity       string          `json:"ident"`
+	RemoteIdentity string          `json:"remote_ident"`
+	KeyType        api.SelectedMap `json:"keyType"`
+	Key            string          `json:"Key"`
+	Description    string          `json:"description"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the pre-shared key is not logged.
+
+func (c *Controller) AddPSK(ctx context.Context, resource *PSK) (string, error) {
+	return apiutil.Add(c.Client(), ctx, PSKOpts, resource)
+}
+
+func (c *Controller) GetPSK(ctx context.Context, id string) (*PSK, error) {
+	return apiutil.Get(c.Client(), ctx, PSKOpts, &PSK{}, id)
+}
+
+func (c *Controller) UpdatePSK(ctx context.Context, id string, resource *PSK) error {
+	return apiutil.Update(c.Client(), ctx, PSKOpts, resource, id)
+}
+
+func (c *Controller) DeletePSK(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, PSKOpts, id)
+}
@@ -0,0 +1,51 @@
+package ipsec
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var RemoteAuthOpts = api.ReqOpts{
+	AddEndpoint:         "/ipsec/connections/addRemote",
+	GetEndpoint:         "/ipsec/connections/getRemote",
+	UpdateEndpoint:      "/ipsec/connections/setRemote",
+	DeleteEndpoint:      "/ipsec/connections/delRemote",
+	ReconfigureEndpoint: ipsecReconfigureEndpoint,
+	Monad:               "remote",
+}
+
+// Data structs
+
+// RemoteAuth is the remote authentication of a connection, called remote in
+// the OPNsense API.
+type RemoteAuth struct {
+	Enabled     string              `json:"enabled"`
+	Connection  api.SelectedMap     `json:"connection"`
+	Round       string              `json:"round"`
+	Auth        api.SelectedMap     `json:"auth"`
+	Id          string              `json:"id"`
+	EAPId       string              `json:"eap_id"`
+	Groups      api.SelectedMapList `json:"groups"`
+	Certs       api.SelectedMapList `json:"certs"`
+	CACerts     api.SelectedMapList `json:"cacerts"`
+	PubKeys     api.SelectedMapList `json:"pubkeys"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddRemoteAuth(ctx context.Context, resource *RemoteAuth) (string, error) {
+	return api.Add(c.Client(), ctx, RemoteAuthOpts, resource)
+}
+
+func (c *Controller) GetRemoteAuth(ctx context.Context, id string) (*RemoteAuth, error) {
+	return api.Get(c.Client(), ctx, RemoteAuthOpts, &RemoteAuth{}, id)
+}
+
+func (c *Controller) UpdateRemoteAuth(ctx context.Context, id string, resource *RemoteAuth) error {
+	return api.Update(c.Client(), ctx, RemoteAuthOpts, resource, id)
+}
+
+func (c *Controller) DeleteRemoteAuth(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, RemoteAuthOpts, id)
+}
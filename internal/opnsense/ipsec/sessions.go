@@ -0,0 +1,32 @@
+package ipsec
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const phase1SearchEndpoint = "/ipsec/sessions/searchPhase1"
+
+// Data structs
+
+// Phase1 is the status of a connection (IKE SA) as returned by the search
+// endpoint.
+type Phase1 struct {
+	Name        apiutil.Scalar `json:"name"`
+	Description apiutil.Scalar `json:"phase1desc"`
+	Connected   apiutil.Scalar `json:"connected"`
+	Version     apiutil.Scalar `json:"version"`
+	LocalAddrs  apiutil.Scalar `json:"local-addrs"`
+	RemoteAddrs apiutil.Scalar `json:"remote-addrs"`
+	LocalId     apiutil.Scalar `json:"local-id"`
+	RemoteId    apiutil.Scalar `json:"remote-id"`
+	InstallTime apiutil.Scalar `json:"install-time"`
+	BytesIn     apiutil.Scalar `json:"bytes-in"`
+	BytesOut    apiutil.Scalar `json:"bytes-out"`
+}
+
+// Search operations
+
+func (c *Controller) SearchPhase1(ctx context.Context) ([]Phase1, error) {
+	return apiutil.Search[Phase1](c.Client(), ctx, phase1SearchEndpoint)
+}
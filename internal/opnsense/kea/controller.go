@@ -0,0 +1,23 @@
+package kea
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+const keaReconfigureEndpoint = "/kea/service/reconfigure"
+
+// Controller for kea
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
+
+// Reconfigure applies the saved configuration to the Kea services. Changes
+// to subnets and reservations are not applied until this is called.
+func (c *Controller) Reconfigure(ctx context.Context) error {
+	return c.Client().ReconfigureService(ctx, keaReconfigureEndpoint)
+}
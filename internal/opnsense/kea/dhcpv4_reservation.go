@@ -0,0 +1,43 @@
+package kea
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var Dhcpv4ReservationOpts = api.ReqOpts{
+	AddEndpoint:         "/kea/dhcpv4/addReservation",
+	GetEndpoint:         "/kea/dhcpv4/getReservation",
+	UpdateEndpoint:      "/kea/dhcpv4/setReservation",
+	DeleteEndpoint:      "/kea/dhcpv4/delReservation",
+	ReconfigureEndpoint: "",
+	Monad:               "reservation",
+}
+
+// Data structs
+
+type Dhcpv4Reservation struct {
+	Subnet      api.SelectedMap `json:"subnet"`
+	IPAddress   string          `json:"ip_address"`
+	HWAddress   string          `json:"hw_address"`
+	Hostname    string          `json:"hostname"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDhcpv4Reservation(ctx context.Context, resource *Dhcpv4Reservation) (string, error) {
+	return api.Add(c.Client(), ctx, Dhcpv4ReservationOpts, resource)
+}
+
+func (c *Controller) GetDhcpv4Reservation(ctx context.Context, id string) (*Dhcpv4Reservation, error) {
+	return api.Get(c.Client(), ctx, Dhcpv4ReservationOpts, &Dhcpv4Reservation{}, id)
+}
+
+func (c *Controller) UpdateDhcpv4Reservation(ctx context.Context, id string, resource *Dhcpv4Reservation) error {
+	return api.Update(c.Client(), ctx, Dhcpv4ReservationOpts, resource, id)
+}
+
+func (c *Controller) DeleteDhcpv4Reservation(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, Dhcpv4ReservationOpts, id)
+}
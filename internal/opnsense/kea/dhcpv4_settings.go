@@ -0,0 +1,42 @@
+package kea
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+// The general DHCPv4 settings are read and written through the settings
+// get/set endpoints. Like subnets, they are applied by Reconfigure.
+var Dhcpv4SettingsOpts = api.ReqOpts{
+	GetEndpoint:         "/kea/dhcpv4",
+	UpdateEndpoint:      "/kea/dhcpv4",
+	ReconfigureEndpoint: "",
+	Monad:               "dhcpv4",
+}
+
+// Data structs
+
+type Dhcpv4Settings struct {
+	Enabled       string              `json:"enabled"`
+	Interfaces    api.SelectedMapList `json:"interfaces"`
+	ValidLifetime string              `json:"valid_lifetime"`
+	FirewallRules string              `json:"fwrules"`
+}
+
+type dhcpv4Settings struct {
+	General Dhcpv4Settings `json:"general"`
+}
+
+// Settings operations
+
+func (c *Controller) GetDhcpv4Settings(ctx context.Context) (*Dhcpv4Settings, error) {
+	settings, err := api.Get(c.Client(), ctx, Dhcpv4SettingsOpts, &dhcpv4Settings{}, "get")
+	if err != nil {
+		return nil, err
+	}
+	return &settings.General, nil
+}
+
+func (c *Controller) UpdateDhcpv4Settings(ctx context.Context, resource *Dhcpv4Settings) error {
+	return api.Update(c.Client(), ctx, Dhcpv4SettingsOpts, &dhcpv4Settings{General: *resource}, "set")
+}
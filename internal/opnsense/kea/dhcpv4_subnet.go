@@ -0,0 +1,55 @@
+package kea
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+// Kea is reconfigured separately (see Reconfigure), so many subnets and
+// reservations can be changed before restarting the services once.
+var Dhcpv4SubnetOpts = api.ReqOpts{
+	AddEndpoint:         "/kea/dhcpv4/addSubnet",
+	GetEndpoint:         "/kea/dhcpv4/getSubnet",
+	UpdateEndpoint:      "/kea/dhcpv4/setSubnet",
+	DeleteEndpoint:      "/kea/dhcpv4/delSubnet",
+	ReconfigureEndpoint: "",
+	Monad:               "subnet4",
+}
+
+// Data structs
+
+type Dhcpv4OptionData struct {
+	DomainNameServers apiutil.OrderedList `json:"domain_name_servers"`
+	DomainSearch      apiutil.OrderedList `json:"domain_search"`
+	Routers           apiutil.OrderedList `json:"routers"`
+	DomainName        string              `json:"domain_name"`
+	NTPServers        apiutil.OrderedList `json:"ntp_servers"`
+}
+
+type Dhcpv4Subnet struct {
+	Subnet                string           `json:"subnet"`
+	NextServer            string           `json:"next_server"`
+	OptionDataAutocollect string           `json:"option_data_autocollect"`
+	OptionData            Dhcpv4OptionData `json:"option_data"`
+	Pools                 string           `json:"pools"`
+	Description           string           `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDhcpv4Subnet(ctx context.Context, resource *Dhcpv4Subnet) (string, error) {
+	return api.Add(c.Client(), ctx, Dhcpv4SubnetOpts, resource)
+}
+
+func (c *Controller) GetDhcpv4Subnet(ctx context.Context, id string) (*Dhcpv4Subnet, error) {
+	return api.Get(c.Client(), ctx, Dhcpv4SubnetOpts, &Dhcpv4Subnet{}, id)
+}
+
+func (c *Controller) UpdateDhcpv4Subnet(ctx context.Context, id string, resource *Dhcpv4Subnet) error {
+	return api.Update(c.Client(), ctx, Dhcpv4SubnetOpts, resource, id)
+}
+
+func (c *Controller) DeleteDhcpv4Subnet(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, Dhcpv4SubnetOpts, id)
+}
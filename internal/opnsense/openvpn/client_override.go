@@ -0,0 +1,53 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var ClientOverrideOpts = api.ReqOpts{
+	AddEndpoint:         "/openvpn/client_overwrites/add",
+	GetEndpoint:         "/openvpn/client_overwrites/get",
+	UpdateEndpoint:      "/openvpn/client_overwrites/set",
+	DeleteEndpoint:      "/openvpn/client_overwrites/del",
+	ReconfigureEndpoint: openvpnReconfigureEndpoint,
+	Monad:               "cso",
+}
+
+// Data structs
+
+// ClientOverride is called a client specific overwrite in the OPNsense API.
+type ClientOverride struct {
+	Enabled           string              `json:"enabled"`
+	Servers           api.SelectedMapList `json:"servers"`
+	CommonName        string              `json:"common_name"`
+	Block             string              `json:"block"`
+	PushReset         string              `json:"push_reset"`
+	TunnelNetwork     string              `json:"tunnel_network"`
+	TunnelNetworkIPv6 string              `json:"tunnel_networkv6"`
+	LocalNetworks     apiutil.OrderedList `json:"local_networks"`
+	RemoteNetworks    apiutil.OrderedList `json:"remote_networks"`
+	RedirectGateway   api.SelectedMapList `json:"redirect_gateway"`
+	DNSServers        apiutil.OrderedList `json:"dns_servers"`
+	DNSDomain         string              `json:"dns_domain"`
+	Description       string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddClientOverride(ctx context.Context, resource *ClientOverride) (string, error) {
+	return api.Add(c.Client(), ctx, ClientOverrideOpts, resource)
+}
+
+func (c *Controller) GetClientOverride(ctx context.Context, id string) (*ClientOverride, error) {
+	return api.Get(c.Client(), ctx, ClientOverrideOpts, &ClientOverride{}, id)
+}
+
+func (c *Controller) UpdateClientOverride(ctx context.Context, id string, resource *ClientOverride) error {
+	return api.Update(c.Client(), ctx, ClientOverrideOpts, resource, id)
+}
+
+func (c *Controller) DeleteClientOverride(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, ClientOverrideOpts, id)
+}
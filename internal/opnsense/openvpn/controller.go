@@ -0,0 +1,16 @@
+package openvpn
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+const openvpnReconfigureEndpoint = "/openvpn/service/reconfigure"
+
+// Controller for openvpn
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
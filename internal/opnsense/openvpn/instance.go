@@ -0,0 +1,70 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var InstanceOpts = api.ReqOpts{
+	AddEndpoint:         "/openvpn/instances/add",
+	GetEndpoint:         "/openvpn/instances/get",
+	UpdateEndpoint:      "/openvpn/instances/set",
+	DeleteEndpoint:      "/openvpn/instances/del",
+	ReconfigureEndpoint: openvpnReconfigureEndpoint,
+	Monad:               "instance",
+}
+
+// Data structs
+
+type Instance struct {
+	Enabled              string              `json:"enabled"`
+	Description          string              `json:"description"`
+	Role                 api.SelectedMap     `json:"role"`
+	DevType              api.SelectedMap     `json:"dev_type"`
+	Proto                api.SelectedMap     `json:"proto"`
+	Port                 string              `json:"port"`
+	Local                string              `json:"local"`
+	Topology             api.SelectedMap     `json:"topology"`
+	Remote               apiutil.OrderedList `json:"remote"`
+	Server               string              `json:"server"`
+	ServerIPv6           string              `json:"server_ipv6"`
+	Cert                 api.SelectedMap     `json:"cert"`
+	CA                   api.SelectedMap     `json:"ca"`
+	VerifyClientCert     api.SelectedMap     `json:"verify_client_cert"`
+	Auth                 api.SelectedMap     `json:"auth"`
+	DataCiphers          api.SelectedMapList `json:"data-ciphers"`
+	TLSKey               api.SelectedMap     `json:"tls_key"`
+	AuthMode             api.SelectedMapList `json:"authmode"`
+	Username             string              `json:"username"`
+	Password             string              `json:"password"`
+	UsernameAsCommonName string              `json:"username_as_common_name"`
+	Route                apiutil.OrderedList `json:"route"`
+	PushRoute            apiutil.OrderedList `json:"push_route"`
+	RedirectGateway      api.SelectedMapList `json:"redirect_gateway"`
+	DNSServers           apiutil.OrderedList `json:"dns_servers"`
+	DNSDomain            string              `json:"dns_domain"`
+	KeepaliveInterval    string              `json:"keepalive_interval"`
+	KeepaliveTimeout     string              `json:"keepalive_timeout"`
+	MaxClients           string              `json:"maxclients"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the password is not logged.
+
+func (c *Controller) AddInstance(ctx context.Context, resource *Instance) (string, error) {
+	return apiutil.Add(c.Client(), ctx, InstanceOpts, resource)
+}
+
+func (c *Controller) GetInstance(ctx context.Context, id string) (*Instance, error) {
+	return apiutil.Get(c.Client(), ctx, InstanceOpts, &Instance{}, id)
+}
+
+func (c *Controller) UpdateInstance(ctx context.Context, id string, resource *Instance) error {
+	return apiutil.Update(c.Client(), ctx, InstanceOpts, resource, id)
+}
+
+func (c *Controller) DeleteInstance(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, InstanceOpts, id)
+}
@@ -0,0 +1,68 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var StaticKeyOpts = api.ReqOpts{
+	AddEndpoint:         "/openvpn/instances/addStaticKey",
+	GetEndpoint:         "/openvpn/instances/getStaticKey",
+	UpdateEndpoint:      "/openvpn/instances/setStaticKey",
+	DeleteEndpoint:      "/openvpn/instances/delStaticKey",
+	ReconfigureEndpoint: openvpnReconfigureEndpoint,
+	Monad:               "statickey",
+}
+
+const genKeyEndpoint = "/openvpn/instances/genKey"
+
+// Data structs
+
+type StaticKey struct {
+	Mode        api.SelectedMap `json:"mode"`
+	Key         string          `json:"key"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the key is not logged.
+
+func (c *Controller) AddStaticKey(ctx context.Context, resource *StaticKey) (string, error) {
+	return apiutil.Add(c.Client(), ctx, StaticKeyOpts, resource)
+}
+
+func (c *Controller) GetStaticKey(ctx context.Context, id string) (*StaticKey, error) {
+	return apiutil.Get(c.Client(), ctx, StaticKeyOpts, &StaticKey{}, id)
+}
+
+func (c *Controller) UpdateStaticKey(ctx context.Context, id string, resource *StaticKey) error {
+	return apiutil.Update(c.Client(), ctx, StaticKeyOpts, resource, id)
+}
+
+func (c *Controller) DeleteStaticKey(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, StaticKeyOpts, id)
+}
+
+// Key operations
+
+// GenerateStaticKey generates a new OpenVPN static key on the OPNsense host.
+func (c *Controller) GenerateStaticKey(ctx context.Context) (string, error) {
+	respJson := &struct {
+		Result string `json:"result"`
+		Key    string `json:"key"`
+	}{}
+
+	err := apiutil.Do(c.Client(), ctx, "GET", genKeyEndpoint, nil, respJson)
+	if err != nil {
+		return "", err
+	}
+
+	if respJson.Result != "ok" || respJson.Key == "" {
+		return "", fmt.Errorf("static key not generated. result: %s", respJson.Result)
+	}
+
+	return respJson.Key, nil
+}
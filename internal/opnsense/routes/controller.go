@@ -0,0 +1,10 @@
+package routes
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/routes"
+)
+
+// Controller for routes, extends the upstream opnsense-go controller
+type Controller struct {
+	routes.Controller
+}
@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const routeSearchEndpoint = "/routes/routes/searchroute"
+
+// Data structs
+
+// RouteRow is a route as returned by the search endpoint. The gateway is only
+// returned as its description.
+type RouteRow struct {
+	Id          string `json:"uuid"`
+	Disabled    string `json:"disabled"`
+	Network     string `json:"network"`
+	Gateway     string `json:"gateway"`
+	Description string `json:"descr"`
+}
+
+// Search operations
+
+func (c *Controller) SearchRoutes(ctx context.Context) ([]RouteRow, error) {
+	return apiutil.Search[RouteRow](c.Client(), ctx, routeSearchEndpoint)
+}
@@ -0,0 +1,16 @@
+package syslog
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+const syslogReconfigureEndpoint = "/syslog/service/reconfigure"
+
+// Controller for syslog
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
@@ -0,0 +1,73 @@
+package syslog
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const settingsGetEndpoint = "/syslog/settings/get"
+
+var DestinationOpts = api.ReqOpts{
+	AddEndpoint:         "/syslog/settings/addDestination",
+	GetEndpoint:         "/syslog/settings/getDestination",
+	UpdateEndpoint:      "/syslog/settings/setDestination",
+	DeleteEndpoint:      "/syslog/settings/delDestination",
+	ReconfigureEndpoint: syslogReconfigureEndpoint,
+	Monad:               "destination",
+}
+
+// Data structs
+
+type Destination struct {
+	Enabled     string              `json:"enabled"`
+	Transport   api.SelectedMap     `json:"transport"`
+	Program     api.SelectedMapList `json:"program"`
+	Level       api.SelectedMapList `json:"level"`
+	Facility    api.SelectedMapList `json:"facility"`
+	Hostname    string              `json:"hostname"`
+	Certificate api.SelectedMap     `json:"certificate"`
+	Port        string              `json:"port"`
+	RFC5424     string              `json:"rfc5424"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddDestination(ctx context.Context, resource *Destination) (string, error) {
+	return api.Add(c.Client(), ctx, DestinationOpts, resource)
+}
+
+func (c *Controller) GetDestination(ctx context.Context, id string) (*Destination, error) {
+	return api.Get(c.Client(), ctx, DestinationOpts, &Destination{}, id)
+}
+
+func (c *Controller) UpdateDestination(ctx context.Context, id string, resource *Destination) error {
+	return api.Update(c.Client(), ctx, DestinationOpts, resource, id)
+}
+
+func (c *Controller) DeleteDestination(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, DestinationOpts, id)
+}
+
+// List operations
+
+// ListDestinations returns all configured destinations, keyed by UUID. The
+// settings endpoint is used over the search endpoint, since the latter only
+// returns the display values of the option fields.
+func (c *Controller) ListDestinations(ctx context.Context) (map[string]Destination, error) {
+	respJson := &struct {
+		Syslog struct {
+			Destinations struct {
+				Destination apiutil.Items[Destination] `json:"destination"`
+			} `json:"destinations"`
+		} `json:"syslog"`
+	}{}
+
+	err := apiutil.Do(c.Client(), ctx, "GET", settingsGetEndpoint, nil, respJson)
+	if err != nil {
+		return nil, err
+	}
+
+	return respJson.Syslog.Destinations.Destination, nil
+}
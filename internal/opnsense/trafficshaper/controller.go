@@ -0,0 +1,16 @@
+package trafficshaper
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+const trafficShaperReconfigureEndpoint = "/trafficshaper/service/reconfigure"
+
+// Controller for the traffic shaper
+type Controller struct {
+	Api *api.Client
+}
+
+func (c *Controller) Client() *api.Client {
+	return c.Api
+}
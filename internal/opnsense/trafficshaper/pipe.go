@@ -0,0 +1,48 @@
+package trafficshaper
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var PipeOpts = api.ReqOpts{
+	AddEndpoint:         "/trafficshaper/settings/addPipe",
+	GetEndpoint:         "/trafficshaper/settings/getPipe",
+	UpdateEndpoint:      "/trafficshaper/settings/setPipe",
+	DeleteEndpoint:      "/trafficshaper/settings/delPipe",
+	ReconfigureEndpoint: trafficShaperReconfigureEndpoint,
+	Monad:               "pipe",
+}
+
+// Data structs
+
+// Pipe is a dummynet pipe. The pipe number is assigned by OPNsense and is not
+// sent, so that updates keep the existing number.
+type Pipe struct {
+	Enabled         string          `json:"enabled"`
+	Bandwidth       string          `json:"bandwidth"`
+	BandwidthMetric api.SelectedMap `json:"bandwidthMetric"`
+	Queue           string          `json:"queue"`
+	Mask            api.SelectedMap `json:"mask"`
+	Scheduler       api.SelectedMap `json:"scheduler"`
+	Delay           string          `json:"delay"`
+	Description     string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddPipe(ctx context.Context, resource *Pipe) (string, error) {
+	return api.Add(c.Client(), ctx, PipeOpts, resource)
+}
+
+func (c *Controller) GetPipe(ctx context.Context, id string) (*Pipe, error) {
+	return api.Get(c.Client(), ctx, PipeOpts, &Pipe{}, id)
+}
+
+func (c *Controller) UpdatePipe(ctx context.Context, id string, resource *Pipe) error {
+	return api.Update(c.Client(), ctx, PipeOpts, resource, id)
+}
+
+func (c *Controller) DeletePipe(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, PipeOpts, id)
+}
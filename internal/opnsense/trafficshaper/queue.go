@@ -0,0 +1,45 @@
+package trafficshaper
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var QueueOpts = api.ReqOpts{
+	AddEndpoint:         "/trafficshaper/settings/addQueue",
+	GetEndpoint:         "/trafficshaper/settings/getQueue",
+	UpdateEndpoint:      "/trafficshaper/settings/setQueue",
+	DeleteEndpoint:      "/trafficshaper/settings/delQueue",
+	ReconfigureEndpoint: trafficShaperReconfigureEndpoint,
+	Monad:               "queue",
+}
+
+// Data structs
+
+// Queue is a dummynet queue. Like pipes, the queue number is assigned by
+// OPNsense and is not sent.
+type Queue struct {
+	Enabled     string          `json:"enabled"`
+	Pipe        api.SelectedMap `json:"pipe"`
+	Weight      string          `json:"weight"`
+	Mask        api.SelectedMap `json:"mask"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddQueue(ctx context.Context, resource *Queue) (string, error) {
+	return api.Add(c.Client(), ctx, QueueOpts, resource)
+}
+
+func (c *Controller) GetQueue(ctx context.Context, id string) (*Queue, error) {
+	return api.Get(c.Client(), ctx, QueueOpts, &Queue{}, id)
+}
+
+func (c *Controller) UpdateQueue(ctx context.Context, id string, resource *Queue) error {
+	return api.Update(c.Client(), ctx, QueueOpts, resource, id)
+}
+
+func (c *Controller) DeleteQueue(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, QueueOpts, id)
+}
@@ -0,0 +1,57 @@
+package trust
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var CAOpts = api.ReqOpts{
+	AddEndpoint:    "/trust/ca/add",
+	GetEndpoint:    "/trust/ca/get",
+	UpdateEndpoint: "/trust/ca/set",
+	DeleteEndpoint: "/trust/ca/del",
+	Monad:          "ca",
+}
+
+// Data structs
+
+type CA struct {
+	RefId              string          `json:"refid,omitempty"`
+	Description        string          `json:"descr"`
+	Action             api.SelectedMap `json:"action"`
+	ParentCA           api.SelectedMap `json:"caref"`
+	KeyType            api.SelectedMap `json:"key_type"`
+	Digest             api.SelectedMap `json:"digest"`
+	Lifetime           string          `json:"lifetime"`
+	CommonName         string          `json:"commonname"`
+	Country            api.SelectedMap `json:"country"`
+	State              string          `json:"state"`
+	City               string          `json:"city"`
+	Organization       string          `json:"organization"`
+	OrganizationalUnit string          `json:"organizationalunit"`
+	Email              string          `json:"email"`
+	Certificate        string          `json:"crt_payload"`
+	PrivateKey         string          `json:"prv_payload"`
+	EncodedCertificate string          `json:"crt,omitempty"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the private key is not logged.
+
+func (c *Controller) AddCA(ctx context.Context, resource *CA) (string, error) {
+	return apiutil.Add(c.Client(), ctx, CAOpts, resource)
+}
+
+func (c *Controller) GetCA(ctx context.Context, id string) (*CA, error) {
+	return apiutil.Get(c.Client(), ctx, CAOpts, &CA{}, id)
+}
+
+func (c *Controller) UpdateCA(ctx context.Context, id string, resource *CA) error {
+	return apiutil.Update(c.Client(), ctx, CAOpts, resource, id)
+}
+
+func (c *Controller) DeleteCA(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, CAOpts, id)
+}
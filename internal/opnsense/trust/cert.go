@@ -0,0 +1,62 @@
+package trust
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var CertOpts = api.ReqOpts{
+	AddEndpoint:    "/trust/cert/add",
+	GetEndpoint:    "/trust/cert/get",
+	UpdateEndpoint: "/trust/cert/set",
+	DeleteEndpoint: "/trust/cert/del",
+	Monad:          "cert",
+}
+
+// Data structs
+
+type Cert struct {
+	RefId              string          `json:"refid,omitempty"`
+	Description        string          `json:"descr"`
+	Action             api.SelectedMap `json:"action"`
+	CA                 api.SelectedMap `json:"caref"`
+	CertType           api.SelectedMap `json:"cert_type"`
+	KeyType            api.SelectedMap `json:"key_type"`
+	Digest             api.SelectedMap `json:"digest"`
+	Lifetime           string          `json:"lifetime"`
+	CommonName         string          `json:"commonname"`
+	Country            api.SelectedMap `json:"country"`
+	State              string          `json:"state"`
+	City               string          `json:"city"`
+	Organization       string          `json:"organization"`
+	OrganizationalUnit string          `json:"organizationalunit"`
+	Email              string          `json:"email"`
+	AltNamesDNS        string          `json:"altnames_dns"`
+	AltNamesIP         string          `json:"altnames_ip"`
+	AltNamesURI        string          `json:"altnames_uri"`
+	AltNamesEmail      string          `json:"altnames_email"`
+	Certificate        string          `json:"crt_payload"`
+	PrivateKey         string          `json:"prv_payload"`
+	EncodedCertificate string          `json:"crt,omitempty"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the private key is not logged.
+
+func (c *Controller) AddCert(ctx context.Context, resource *Cert) (string, error) {
+	return apiutil.Add(c.Client(), ctx, CertOpts, resource)
+}
+
+func (c *Controller) GetCert(ctx context.Context, id string) (*Cert, error) {
+	return apiutil.Get(c.Client(), ctx, CertOpts, &Cert{}, id)
+}
+
+func (c *Controller) UpdateCert(ctx context.Context, id string, resource *Cert) error {
+	return apiutil.Update(c.Client(), ctx, CertOpts, resource, id)
+}
+
+func (c *Controller) DeleteCert(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, CertOpts, id)
+}
@@ -0,0 +1,43 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+var ACLOpts = api.ReqOpts{
+	AddEndpoint:         "/unbound/settings/addAcl",
+	GetEndpoint:         "/unbound/settings/getAcl",
+	UpdateEndpoint:      "/unbound/settings/setAcl",
+	DeleteEndpoint:      "/unbound/settings/delAcl",
+	ReconfigureEndpoint: unboundReconfigureEndpoint,
+	Monad:               "acl",
+}
+
+// Data structs
+
+type ACL struct {
+	Enabled     string              `json:"enabled"`
+	Name        string              `json:"name"`
+	Action      api.SelectedMap     `json:"action"`
+	Networks    api.SelectedMapList `json:"networks"`
+	Description string              `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddACL(ctx context.Context, resource *ACL) (string, error) {
+	return api.Add(c.Client(), ctx, ACLOpts, resource)
+}
+
+func (c *Controller) GetACL(ctx context.Context, id string) (*ACL, error) {
+	return api.Get(c.Client(), ctx, ACLOpts, &ACL{}, id)
+}
+
+func (c *Controller) UpdateACL(ctx context.Context, id string, resource *ACL) error {
+	return api.Update(c.Client(), ctx, ACLOpts, resource, id)
+}
+
+func (c *Controller) DeleteACL(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, ACLOpts, id)
+}
@@ -0,0 +1,12 @@
+package unbound
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+)
+
+const unboundReconfigureEndpoint = "/unbound/service/reconfigure"
+
+// Controller for unbound, extends the upstream opnsense-go controller
+type Controller struct {
+	unbound.Controller
+}
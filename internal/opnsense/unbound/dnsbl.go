@@ -0,0 +1,54 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+)
+
+// DNSBL is part of the general Unbound settings, so it is read and written
+// through the settings get/set endpoints. Applying it requires the dnsbl
+// action, which downloads the lists, before the service is reconfigured.
+var DNSBLOpts = api.ReqOpts{
+	GetEndpoint:         "/unbound/settings",
+	UpdateEndpoint:      "/unbound/settings",
+	ReconfigureEndpoint: "/unbound/service/dnsbl",
+	Monad:               "unbound",
+}
+
+// Data structs
+
+type DNSBL struct {
+	Enabled    string              `json:"enabled"`
+	SafeSearch string              `json:"safesearch"`
+	Type       api.SelectedMapList `json:"type"`
+	Lists      api.SelectedMapList `json:"lists"`
+	Whitelists api.SelectedMapList `json:"whitelists"`
+	Blocklists api.SelectedMapList `json:"blocklists"`
+	Wildcards  api.SelectedMapList `json:"wildcards"`
+	Address    string              `json:"address"`
+	NXDomain   string              `json:"nxdomain"`
+}
+
+type dnsblSettings struct {
+	DNSBL DNSBL `json:"dnsbl"`
+}
+
+// Settings operations
+
+func (c *Controller) GetDNSBL(ctx context.Context) (*DNSBL, error) {
+	settings, err := api.Get(c.Client(), ctx, DNSBLOpts, &dnsblSettings{}, "get")
+	if err != nil {
+		return nil, err
+	}
+	return &settings.DNSBL, nil
+}
+
+func (c *Controller) UpdateDNSBL(ctx context.Context, resource *DNSBL) error {
+	err := api.Update(c.Client(), ctx, DNSBLOpts, &dnsblSettings{DNSBL: *resource}, "set")
+	if err != nil {
+		return err
+	}
+
+	// Reload unbound with the updated blocklists
+	return c.Client().ReconfigureService(ctx, unboundReconfigureEndpoint)
+}
@@ -0,0 +1,25 @@
+package unbound
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const domainOverrideSearchEndpoint = "/unbound/settings/searchDomainOverride"
+
+// Data structs
+
+// DomainOverrideRow is a domain override as returned by the search endpoint.
+type DomainOverrideRow struct {
+	Id          string `json:"uuid"`
+	Enabled     string `json:"enabled"`
+	Domain      string `json:"domain"`
+	Server      string `json:"server"`
+	Description string `json:"description"`
+}
+
+// Search operations
+
+func (c *Controller) SearchDomainOverrides(ctx context.Context) ([]DomainOverrideRow, error) {
+	return apiutil.Search[DomainOverrideRow](c.Client(), ctx, domainOverrideSearchEndpoint)
+}
@@ -0,0 +1,30 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const hostAliasSearchEndpoint = "/unbound/settings/searchHostAlias"
+
+// Data structs
+
+type HostAlias = unbound.HostAlias
+
+// HostAliasRow is a host alias as returned by the search endpoint. The parent
+// host override is only returned as its description, use GetHostAlias to
+// resolve its UUID.
+type HostAliasRow struct {
+	Id          string `json:"uuid"`
+	Enabled     string `json:"enabled"`
+	Hostname    string `json:"hostname"`
+	Domain      string `json:"domain"`
+	Description string `json:"description"`
+}
+
+// Search operations
+
+func (c *Controller) SearchHostAliases(ctx context.Context) ([]HostAliasRow, error) {
+	return apiutil.Search[HostAliasRow](c.Client(), ctx, hostAliasSearchEndpoint)
+}
@@ -0,0 +1,44 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/unbound"
+)
+
+// HostOverrideOpts are the same as upstream, only the data struct differs.
+var HostOverrideOpts = unbound.HostOverrideOpts
+
+// Data structs
+
+// HostOverride extends the upstream struct with the record types supported
+// by newer OPNsense versions.
+type HostOverride struct {
+	Enabled     string          `json:"enabled"`
+	Hostname    string          `json:"hostname"`
+	Domain      string          `json:"domain"`
+	Type        api.SelectedMap `json:"rr"`
+	Server      string          `json:"server"`
+	MXPriority  string          `json:"mxprio"`
+	MXDomain    string          `json:"mx"`
+	TXTData     string          `json:"txtdata"`
+	Description string          `json:"description"`
+}
+
+// CRUD operations
+
+func (c *Controller) AddHostOverride(ctx context.Context, resource *HostOverride) (string, error) {
+	return api.Add(c.Client(), ctx, HostOverrideOpts, resource)
+}
+
+func (c *Controller) GetHostOverride(ctx context.Context, id string) (*HostOverride, error) {
+	return api.Get(c.Client(), ctx, HostOverrideOpts, &HostOverride{}, id)
+}
+
+func (c *Controller) UpdateHostOverride(ctx context.Context, id string, resource *HostOverride) error {
+	return api.Update(c.Client(), ctx, HostOverrideOpts, resource, id)
+}
+
+func (c *Controller) DeleteHostOverride(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, HostOverrideOpts, id)
+}
@@ -0,0 +1,102 @@
+package unbound
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+const hostOverrideSearchEndpoint = "/unbound/settings/searchHostOverride"
+
+// hostOverrideBatchOpts do not reconfigure unbound, so many host overrides can
+// be changed before applying them all at once.
+var hostOverrideBatchOpts = api.ReqOpts{
+	AddEndpoint:    HostOverrideOpts.AddEndpoint,
+	UpdateEndpoint: HostOverrideOpts.UpdateEndpoint,
+	DeleteEndpoint: HostOverrideOpts.DeleteEndpoint,
+	Monad:          HostOverrideOpts.Monad,
+}
+
+// Data structs
+
+// HostOverrideRow is a host override as returned by the search endpoint.
+type HostOverrideRow struct {
+	Id          string `json:"uuid"`
+	Enabled     string `json:"enabled"`
+	Hostname    string `json:"hostname"`
+	Domain      string `json:"domain"`
+	Type        string `json:"rr"`
+	Server      string `json:"server"`
+	MXPriority  string `json:"mxprio"`
+	MXDomain    string `json:"mx"`
+	TXTData     string `json:"txtdata"`
+	Description string `json:"description"`
+}
+
+// HostOverride converts the row into a host override. The search endpoint
+// returns the description of the record type (e.g. `A (IPv4 address)`), so
+// only the first word is kept.
+func (r *HostOverrideRow) HostOverride() *HostOverride {
+	recordType, _, _ := strings.Cut(r.Type, " ")
+	return &HostOverride{
+		Enabled:     r.Enabled,
+		Hostname:    r.Hostname,
+		Domain:      r.Domain,
+		Type:        api.SelectedMap(recordType),
+		Server:      r.Server,
+		MXPriority:  r.MXPriority,
+		MXDomain:    r.MXDomain,
+		TXTData:     r.TXTData,
+		Description: r.Description,
+	}
+}
+
+// HostOverrideBatch is a set of changes to apply to host overrides.
+type HostOverrideBatch struct {
+	Add    []*HostOverride
+	Update map[string]*HostOverride
+	Delete []string
+}
+
+// Batch operations
+
+func (c *Controller) SearchHostOverrides(ctx context.Context) ([]HostOverrideRow, error) {
+	return apiutil.Search[HostOverrideRow](c.Client(), ctx, hostOverrideSearchEndpoint)
+}
+
+// ApplyHostOverrideBatch adds, updates and deletes the host overrides in the
+// batch, then reconfigures unbound once. Unbound is reconfigured even if one
+// of the changes fails, so that it runs with the changes that were saved.
+func (c *Controller) ApplyHostOverrideBatch(ctx context.Context, batch *HostOverrideBatch) error {
+	err := c.applyHostOverrideBatch(ctx, batch)
+
+	// Reconfigure (i.e. restart) unbound
+	if reconfigureErr := c.Client().ReconfigureService(ctx, unboundReconfigureEndpoint); err == nil {
+		err = reconfigureErr
+	}
+
+	return err
+}
+
+func (c *Controller) applyHostOverrideBatch(ctx context.Context, batch *HostOverrideBatch) error {
+	for _, id := range batch.Delete {
+		if err := api.Delete(c.Client(), ctx, hostOverrideBatchOpts, id); err != nil {
+			return err
+		}
+	}
+
+	for id, resource := range batch.Update {
+		if err := api.Update(c.Client(), ctx, hostOverrideBatchOpts, resource, id); err != nil {
+			return err
+		}
+	}
+
+	for _, resource := range batch.Add {
+		if _, err := api.Add(c.Client(), ctx, hostOverrideBatchOpts, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
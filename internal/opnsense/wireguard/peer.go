@@ -0,0 +1,50 @@
+package wireguard
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var PeerOpts = api.ReqOpts{
+	AddEndpoint:         "/wireguard/client/addClient",
+	GetEndpoint:         "/wireguard/client/getClient",
+	UpdateEndpoint:      "/wireguard/client/setClient",
+	DeleteEndpoint:      "/wireguard/client/delClient",
+	ReconfigureEndpoint: wireguardReconfigureEndpoint,
+	Monad:               "client",
+}
+
+// Data structs
+
+// Peer is called a client in the OPNsense API.
+type Peer struct {
+	Enabled       string              `json:"enabled"`
+	Name          string              `json:"name"`
+	PublicKey     string              `json:"pubkey"`
+	PSK           string              `json:"psk"`
+	TunnelAddress apiutil.OrderedList `json:"tunneladdress"`
+	ServerAddress string              `json:"serveraddress"`
+	ServerPort    string              `json:"serverport"`
+	Keepalive     string              `json:"keepalive"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the pre-shared key is not logged.
+
+func (c *Controller) AddPeer(ctx context.Context, resource *Peer) (string, error) {
+	return apiutil.Add(c.Client(), ctx, PeerOpts, resource)
+}
+
+func (c *Controller) GetPeer(ctx context.Context, id string) (*Peer, error) {
+	return apiutil.Get(c.Client(), ctx, PeerOpts, &Peer{}, id)
+}
+
+func (c *Controller) UpdatePeer(ctx context.Context, id string, resource *Peer) error {
+	return apiutil.Update(c.Client(), ctx, PeerOpts, resource, id)
+}
+
+func (c *Controller) DeletePeer(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, PeerOpts, id)
+}
@@ -0,0 +1,94 @@
+package wireguard
+
+import (
+	"context"
+	"crypto/ecdh"
+	"encoding/base64"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+)
+
+var ServerOpts = api.ReqOpts{
+	AddEndpoint:         "/wireguard/server/addServer",
+	GetEndpoint:         "/wireguard/server/getServer",
+	UpdateEndpoint:      "/wireguard/server/setServer",
+	DeleteEndpoint:      "/wireguard/server/delServer",
+	ReconfigureEndpoint: wireguardReconfigureEndpoint,
+	Monad:               "server",
+}
+
+const keyPairEndpoint = "/wireguard/server/keyPair"
+
+// Data structs
+
+type Server struct {
+	Enabled       string              `json:"enabled"`
+	Name          string              `json:"name"`
+	PublicKey     string              `json:"pubkey"`
+	PrivateKey    string              `json:"privkey"`
+	Port          string              `json:"port"`
+	MTU           string              `json:"mtu"`
+	DNS           apiutil.OrderedList `json:"dns"`
+	TunnelAddress apiutil.OrderedList `json:"tunneladdress"`
+	DisableRoutes string              `json:"disableroutes"`
+	Gateway       string              `json:"gateway"`
+	Peers         api.SelectedMapList `json:"peers"`
+}
+
+type KeyPair struct {
+	PublicKey  string `json:"pubkey"`
+	PrivateKey string `json:"privkey"`
+}
+
+// CRUD operations
+
+// Requests are sent through apiutil, so the private key is not logged.
+
+func (c *Controller) AddServer(ctx context.Context, resource *Server) (string, error) {
+	return apiutil.Add(c.Client(), ctx, ServerOpts, resource)
+}
+
+func (c *Controller) GetServer(ctx context.Context, id string) (*Server, error) {
+	return apiutil.Get(c.Client(), ctx, ServerOpts, &Server{}, id)
+}
+
+func (c *Controller) UpdateServer(ctx context.Context, id string, resource *Server) error {
+	return apiutil.Update(c.Client(), ctx, ServerOpts, resource, id)
+}
+
+func (c *Controller) DeleteServer(ctx context.Context, id string) error {
+	return api.Delete(c.Client(), ctx, ServerOpts, id)
+}
+
+// Key operations
+
+// GenerateKeyPair generates a new key pair on the OPNsense host.
+func (c *Controller) GenerateKeyPair(ctx context.Context) (*KeyPair, error) {
+	keyPair := &KeyPair{}
+	err := apiutil.Do(c.Client(), ctx, "GET", keyPairEndpoint, nil, keyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyPair.PublicKey == "" || keyPair.PrivateKey == "" {
+		return nil, fmt.Errorf("key pair not generated")
+	}
+
+	return keyPair, nil
+}
+
+// PublicKey derives the public key of a base64 encoded private key.
+func PublicKey(privateKey string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("private key is not base64 encoded: %w", err)
+	}
+
+	key, err := ecdh.X25519().NewPrivateKey(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
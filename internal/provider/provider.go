@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
 	"terraform-provider-opnsense/internal/service"
 )
 
@@ -104,6 +105,7 @@ func (p *OPNsenseProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	client := api.NewClient(opnOptions)
+	apiutil.RegisterClient(client, opnOptions)
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
@@ -114,16 +116,70 @@ func (p *OPNsenseProvider) Resources(ctx context.Context) []func() resource.Reso
 		service.NewInterfacesVlanResource,
 		// Routes
 		service.NewRouteResource,
+		service.NewRouteExclusiveResource,
 		// Unbound
 		service.NewUnboundHostOverrideResource,
+		service.NewUnboundHostOverridesResource,
 		service.NewUnboundHostAliasResource,
 		service.NewUnboundDomainOverrideResource,
 		service.NewUnboundForwardResource,
+		service.NewUnboundACLResource,
+		service.NewUnboundDNSBLResource,
+		service.NewUnboundHostOverrideExclusiveResource,
+		service.NewUnboundHostAliasExclusiveResource,
+		service.NewUnboundDomainOverrideExclusiveResource,
 		// Firewall
 		service.NewFirewallFilterResource,
 		service.NewFirewallNATResource,
 		service.NewFirewallAliasResource,
+		service.NewFirewallAliasEntryResource,
 		service.NewFirewallCategoryResource,
+		service.NewFirewallFilterRulesetResource,
+		service.NewFirewallAliasExclusiveResource,
+		service.NewFirewallCategoryExclusiveResource,
+		// Kea
+		service.NewKeaDhcpv4SubnetResource,
+		service.NewKeaDhcpv4ReservationResource,
+		service.NewKeaDhcpv4SettingsResource,
+		service.NewKeaReconfigureResource,
+		// Dnsmasq
+		service.NewDnsmasqHostOverrideResource,
+		service.NewDnsmasqDomainOverrideResource,
+		service.NewDnsmasqDhcpRangeResource,
+		// WireGuard
+		service.NewWireguardServerResource,
+		service.NewWireguardPeerResource,
+		// OpenVPN
+		service.NewOpenVPNInstanceResource,
+		service.NewOpenVPNStaticKeyResource,
+		service.NewOpenVPNClientOverrideResource,
+		// IPsec
+		service.NewIPsecConnectionResource,
+		service.NewIPsecLocalAuthResource,
+		service.NewIPsecRemoteAuthResource,
+		service.NewIPsecChildResource,
+		service.NewIPsecPSKResource,
+		// Trust
+		service.NewTrustCAResource,
+		service.NewTrustCertResource,
+		// ACME
+		service.NewAcmeAccountResource,
+		service.NewAcmeValidationResource,
+		service.NewAcmeAutomationResource,
+		service.NewAcmeCertificateResource,
+		service.NewAcmeCertificateIssueResource,
+		// Auth
+		service.NewAuthUserResource,
+		service.NewAuthGroupResource,
+		service.NewAuthUserAPIKeyResource,
+		// Cron
+		service.NewCronJobResource,
+		// Syslog
+		service.NewSyslogDestinationResource,
+		// Traffic shaper
+		service.NewTrafficShaperPipeResource,
+		service.NewTrafficShaperQueueResource,
+		service.NewTrafficShaperRuleResource,
 	}
 }
 
@@ -138,11 +194,57 @@ func (p *OPNsenseProvider) DataSources(ctx context.Context) []func() datasource.
 		service.NewUnboundHostAliasDataSource,
 		service.NewUnboundDomainOverrideDataSource,
 		service.NewUnboundForwardDataSource,
+		service.NewUnboundACLDataSource,
+		service.NewUnboundRecordsDataSource,
 		// Firewall
 		service.NewFirewallFilterDataSource,
 		service.NewFirewallNATDataSource,
 		service.NewFirewallAliasDataSource,
+		service.NewFirewallAliasEntriesDataSource,
 		service.NewFirewallCategoryDataSource,
+		// Kea
+		service.NewKeaDhcpv4SubnetDataSource,
+		service.NewKeaDhcpv4ReservationDataSource,
+		// DHCP
+		service.NewDhcpLeasesDataSource,
+		// Dnsmasq
+		service.NewDnsmasqHostOverrideDataSource,
+		service.NewDnsmasqDomainOverrideDataSource,
+		service.NewDnsmasqDhcpRangeDataSource,
+		// WireGuard
+		service.NewWireguardServerDataSource,
+		service.NewWireguardPeerDataSource,
+		// OpenVPN
+		service.NewOpenVPNInstanceDataSource,
+		service.NewOpenVPNStaticKeyDataSource,
+		service.NewOpenVPNClientOverrideDataSource,
+		// IPsec
+		service.NewIPsecConnectionDataSource,
+		service.NewIPsecLocalAuthDataSource,
+		service.NewIPsecRemoteAuthDataSource,
+		service.NewIPsecChildDataSource,
+		service.NewIPsecPSKDataSource,
+		service.NewIPsecSessionsDataSource,
+		// Trust
+		service.NewTrustCADataSource,
+		service.NewTrustCertDataSource,
+		// ACME
+		service.NewAcmeAccountDataSource,
+		service.NewAcmeValidationDataSource,
+		service.NewAcmeAutomationDataSource,
+		service.NewAcmeCertificateDataSource,
+		// Auth
+		service.NewAuthUserDataSource,
+		service.NewAuthGroupDataSource,
+		// Cron
+		service.NewCronJobDataSource,
+		// Syslog
+		service.NewSyslogDestinationDataSource,
+		service.NewSyslogDestinationsDataSource,
+		// Traffic shaper
+		service.NewTrafficShaperPipeDataSource,
+		service.NewTrafficShaperQueueDataSource,
+		service.NewTrafficShaperRuleDataSource,
 	}
 }
 
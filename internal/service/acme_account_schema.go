@@ -0,0 +1,174 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/acme"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// AcmeAccountResourceModel describes the resource data model.
+type AcmeAccountResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Email       types.String `tfsdk:"email"`
+	CA          types.String `tfsdk:"ca"`
+	CustomCA    types.String `tfsdk:"custom_ca"`
+	EABKeyId    types.String `tfsdk:"eab_key_id"`
+	EABHMACKey  types.String `tfsdk:"eab_hmac_key"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func acmeAccountResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "ACME accounts are registered with a certificate authority, e.g. Let's Encrypt, to issue certificates. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this account. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the account.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address to register the account with, used by the CA for expiry notices.",
+				Optional:            true,
+			},
+			"ca": schema.StringAttribute{
+				MarkdownDescription: "Certificate authority to register with. Use `custom` together with `custom_ca` for any other ACME server, e.g. a local test server. Available values: `letsencrypt`, `letsencrypt_test`, `zerossl`, `buypass`, `buypass_test`, `google`, `google_test`, `sslcom`, `custom`. Defaults to `letsencrypt`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("letsencrypt"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("letsencrypt", "letsencrypt_test", "zerossl", "buypass", "buypass_test", "google", "google_test", "sslcom", "custom"),
+				},
+			},
+			"custom_ca": schema.StringAttribute{
+				MarkdownDescription: "URL of the ACME directory, e.g. `https://acme.example.com/directory`. Required when `ca` is `custom`.",
+				Optional:            true,
+			},
+			"eab_key_id": schema.StringAttribute{
+				MarkdownDescription: "Key identifier for External Account Binding, if required by the CA.",
+				Optional:            true,
+			},
+			"eab_hmac_key": schema.StringAttribute{
+				MarkdownDescription: "HMAC key for External Account Binding, if required by the CA.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func AcmeAccountDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "ACME accounts are registered with a certificate authority, e.g. Let's Encrypt, to issue certificates. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this account is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the account.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"email": dschema.StringAttribute{
+				MarkdownDescription: "Email address the account is registered with.",
+				Computed:            true,
+			},
+			"ca": dschema.StringAttribute{
+				MarkdownDescription: "Certificate authority the account is registered with.",
+				Computed:            true,
+			},
+			"custom_ca": dschema.StringAttribute{
+				MarkdownDescription: "URL of the ACME directory, if `ca` is `custom`.",
+				Computed:            true,
+			},
+			"eab_key_id": dschema.StringAttribute{
+				MarkdownDescription: "Key identifier for External Account Binding.",
+				Computed:            true,
+			},
+			"eab_hmac_key": dschema.StringAttribute{
+				MarkdownDescription: "HMAC key for External Account Binding.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func validateAcmeAccountConfig(d *AcmeAccountResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if d.CA.ValueString() == "custom" && d.CustomCA.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("custom_ca"),
+			"Missing Attribute Configuration",
+			"Expected custom_ca to be configured when ca is custom.",
+		)
+	}
+
+	return diagnostics
+}
+
+func convertAcmeAccountSchemaToStruct(d *AcmeAccountResourceModel) (*acme.Account, error) {
+	return &acme.Account{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Name:        d.Name.ValueString(),
+		Description: d.Description.ValueString(),
+		Email:       d.Email.ValueString(),
+		CA:          api.SelectedMap(d.CA.ValueString()),
+		CustomCA:    d.CustomCA.ValueString(),
+		EABKeyId:    d.EABKeyId.ValueString(),
+		EABHMACKey:  d.EABHMACKey.ValueString(),
+	}, nil
+}
+
+func convertAcmeAccountStructToSchema(d *acme.Account) (*AcmeAccountResourceModel, error) {
+	return &AcmeAccountResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Name:        types.StringValue(d.Name),
+		Description: tools.StringOrNull(d.Description),
+		Email:       tools.StringOrNull(d.Email),
+		CA:          types.StringValue(d.CA.String()),
+		CustomCA:    tools.StringOrNull(d.CustomCA),
+		EABKeyId:    tools.StringOrNull(d.EABKeyId),
+		EABHMACKey:  tools.StringOrNull(d.EABHMACKey),
+	}, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/acme"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// acmeAutomationTypes maps the automation types to the values used by
+// OPNsense.
+var acmeAutomationTypes = map[string]string{
+	"restart_gui":     "configd_restart_gui",
+	"restart_haproxy": "configd_restart_haproxy",
+	"restart_nginx":   "configd_restart_nginx",
+	"configd":         "configd_generic",
+}
+
+// AcmeAutomationResourceModel describes the resource data model.
+type AcmeAutomationResourceModel struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	ConfigdCommand types.String `tfsdk:"configd_command"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func acmeAutomationResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "ACME automations run after a certificate is issued or renewed, e.g. to restart the services using it. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this automation. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the automation.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "What to run. Use `configd` to run any configd action, set in `configd_command`. Available values: `restart_gui`, `restart_haproxy`, `restart_nginx`, `configd`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("restart_gui", "restart_haproxy", "restart_nginx", "configd"),
+				},
+			},
+			"configd_command": schema.StringAttribute{
+				MarkdownDescription: "Configd action to run, e.g. `haproxy restart`. Required when `type` is `configd`.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the automation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func AcmeAutomationDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "ACME automations run after a certificate is issued or renewed, e.g. to restart the services using it. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this automation is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the automation.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "What the automation runs. Empty if not one of the supported types.",
+				Computed:            true,
+			},
+			"configd_command": dschema.StringAttribute{
+				MarkdownDescription: "Configd action to run, if `type` is `configd`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func validateAcmeAutomationConfig(d *AcmeAutomationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if d.Type.ValueString() == "configd" && d.ConfigdCommand.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("configd_command"),
+			"Missing Attribute Configuration",
+			"Expected configd_command to be configured when type is configd.",
+		)
+	}
+
+	return diagnostics
+}
+
+func convertAcmeAutomationSchemaToStruct(d *AcmeAutomationResourceModel) (*acme.Automation, error) {
+	return &acme.Automation{
+		Enabled:        tools.BoolToString(d.Enabled.ValueBool()),
+		Name:           d.Name.ValueString(),
+		Description:    d.Description.ValueString(),
+		Type:           api.SelectedMap(acmeAutomationTypes[d.Type.ValueString()]),
+		ConfigdCommand: api.SelectedMap(d.ConfigdCommand.ValueString()),
+	}, nil
+}
+
+func convertAcmeAutomationStructToSchema(d *acme.Automation) (*AcmeAutomationResourceModel, error) {
+	automationType := ""
+	for name, value := range acmeAutomationTypes {
+		if value == d.Type.String() {
+			automationType = name
+		}
+	}
+
+	return &AcmeAutomationResourceModel{
+		Enabled:        types.BoolValue(tools.StringToBool(d.Enabled)),
+		Name:           types.StringValue(d.Name),
+		Description:    tools.StringOrNull(d.Description),
+		Type:           types.StringValue(automationType),
+		ConfigdCommand: tools.StringOrNull(d.ConfigdCommand.String()),
+	}, nil
+}
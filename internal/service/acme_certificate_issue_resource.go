@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"terraform-provider-opnsense/internal/opnsense"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AcmeCertificateIssueResource{}
+
+func NewAcmeCertificateIssueResource() resource.Resource {
+	return &AcmeCertificateIssueResource{}
+}
+
+// AcmeCertificateIssueResource defines the resource implementation.
+type AcmeCertificateIssueResource struct {
+	client opnsense.Client
+}
+
+func (r *AcmeCertificateIssueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_certificate_issue"
+}
+
+func (r *AcmeCertificateIssueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = acmeCertificateIssueResourceSchema()
+}
+
+func (r *AcmeCertificateIssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *AcmeCertificateIssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AcmeCertificateIssueResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue certificate, and wait for it to be stored in the trust store
+	issueCtx, cancel := context.WithTimeout(ctx, time.Duration(data.Timeout.ValueInt64())*time.Second)
+	defer cancel()
+
+	cert, err := r.client.Acme().IssueCertificate(issueCtx, data.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to issue certificate, got error: %s", err))
+		return
+	}
+
+	data.CertRefId = types.StringValue(cert.CertRefId)
+
+	// Nothing is stored remotely, use the time of issuance as ID
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeCertificateIssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AcmeCertificateIssueResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get certificate from OPNsense ACME client API
+	cert, err := r.client.Acme().GetCertificate(ctx, data.Certificate.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("certificate not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate, got error: %s", err))
+		return
+	}
+
+	// Issue again if the certificate was removed from the trust store
+	if cert.CertRefId == "" {
+		tflog.Warn(ctx, fmt.Sprintf("certificate not issued in remote, removing from state"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.CertRefId = types.StringValue(cert.CertRefId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeCertificateIssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AcmeCertificateIssueResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeCertificateIssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing this resource does not revoke or remove the certificate
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AcmeCertificateIssueResourceModel describes the resource data model.
+type AcmeCertificateIssueResourceModel struct {
+	Certificate types.String `tfsdk:"certificate"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+	CertRefId   types.String `tfsdk:"cert_refid"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func acmeCertificateIssueResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Issues an ACME certificate, and waits for it to be stored in the trust store. The certificate is issued again whenever `certificate` or any of the `triggers` change. Renewals are handled by the ACME client itself. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]schema.Attribute{
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "UUID of the certificate (see `opnsense_acme_certificate`) to issue.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will cause the certificate to be issued again, e.g. the domains of the certificate.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to wait for the certificate to be issued. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cert_refid": schema.StringAttribute{
+				MarkdownDescription: "Reference ID of the issued certificate in the trust store, as used by other resources to refer to it.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the issuance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/acme"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// acmeKeyTypes maps the key types to the values used by OPNsense. The names
+// match the key types of opnsense_trust_cert.
+var acmeKeyTypes = map[string]string{
+	"rsa-2048":      "key_2048",
+	"rsa-3072":      "key_3072",
+	"rsa-4096":      "key_4096",
+	"ec-prime256v1": "key_ec256",
+	"ec-secp384r1":  "key_ec384",
+}
+
+// AcmeCertificateResourceModel describes the resource data model.
+type AcmeCertificateResourceModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	CommonName    types.String `tfsdk:"common_name"`
+	Description   types.String `tfsdk:"description"`
+	AltNames      types.List   `tfsdk:"alt_names"`
+	Account       types.String `tfsdk:"account"`
+	Validation    types.String `tfsdk:"validation"`
+	KeyType       types.String `tfsdk:"key_type"`
+	OCSP          types.Bool   `tfsdk:"ocsp"`
+	Automations   types.Set    `tfsdk:"automations"`
+	AutoRenewal   types.Bool   `tfsdk:"auto_renewal"`
+	RenewInterval types.Int64  `tfsdk:"renew_interval"`
+	CertRefId     types.String `tfsdk:"cert_refid"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func acmeCertificateResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "ACME certificates are issued by the CA of an account, and stored in the trust store once issued. Use `opnsense_acme_certificate_issue` to issue the certificate. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this certificate. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"common_name": schema.StringAttribute{
+				MarkdownDescription: "Common name of the certificate, e.g. `www.example.com` or `*.example.com`.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"alt_names": schema.ListAttribute{
+				MarkdownDescription: "Additional domain names of the certificate. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+			},
+			"account": schema.StringAttribute{
+				MarkdownDescription: "UUID of the account (see `opnsense_acme_account`) to issue the certificate with.",
+				Required:            true,
+			},
+			"validation": schema.StringAttribute{
+				MarkdownDescription: "UUID of the validation method (see `opnsense_acme_validation`) to verify the domains with.",
+				Required:            true,
+			},
+			"key_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the private key. Available values: `rsa-2048`, `rsa-3072`, `rsa-4096`, `ec-prime256v1`, `ec-secp384r1`. Defaults to `rsa-4096`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("rsa-4096"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("rsa-2048", "rsa-3072", "rsa-4096", "ec-prime256v1", "ec-secp384r1"),
+				},
+			},
+			"ocsp": schema.BoolAttribute{
+				MarkdownDescription: "Request the OCSP Must-Staple extension. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"automations": schema.SetAttribute{
+				MarkdownDescription: "UUIDs of the automations (see `opnsense_acme_automation`) to run after the certificate is issued or renewed. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"auto_renewal": schema.BoolAttribute{
+				MarkdownDescription: "Renew the certificate automatically. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"renew_interval": schema.Int64Attribute{
+				MarkdownDescription: "Time, in days, after which the certificate is renewed. Defaults to `60`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(60),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5000),
+				},
+			},
+			"cert_refid": schema.StringAttribute{
+				MarkdownDescription: "Reference ID of the issued certificate in the trust store. Not set until the certificate is issued.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func AcmeCertificateDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "ACME certificates are issued by the CA of an account, and stored in the trust store once issued. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this certificate is enabled.",
+				Computed:            true,
+			},
+			"common_name": dschema.StringAttribute{
+				MarkdownDescription: "Common name of the certificate.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"alt_names": dschema.ListAttribute{
+				MarkdownDescription: "Additional domain names of the certificate.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"account": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the account the certificate is issued with.",
+				Computed:            true,
+			},
+			"validation": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the validation method the domains are verified with.",
+				Computed:            true,
+			},
+			"key_type": dschema.StringAttribute{
+				MarkdownDescription: "Type of the private key.",
+				Computed:            true,
+			},
+			"ocsp": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the OCSP Must-Staple extension is requested.",
+				Computed:            true,
+			},
+			"automations": dschema.SetAttribute{
+				MarkdownDescription: "UUIDs of the automations run after the certificate is issued or renewed.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auto_renewal": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the certificate is renewed automatically.",
+				Computed:            true,
+			},
+			"renew_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Time, in days, after which the certificate is renewed.",
+				Computed:            true,
+			},
+			"cert_refid": dschema.StringAttribute{
+				MarkdownDescription: "Reference ID of the issued certificate in the trust store.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertAcmeCertificateSchemaToStruct(d *AcmeCertificateResourceModel) (*acme.Certificate, error) {
+	var altNameList, automationList []string
+
+	ctx := context.Background()
+	d.AltNames.ElementsAs(ctx, &altNameList, false)
+	d.Automations.ElementsAs(ctx, &automationList, false)
+
+	return &acme.Certificate{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Name:          d.CommonName.ValueString(),
+		Description:   d.Description.ValueString(),
+		AltNames:      altNameList,
+		Account:       api.SelectedMap(d.Account.ValueString()),
+		Validation:    api.SelectedMap(d.Validation.ValueString()),
+		KeyLength:     api.SelectedMap(acmeKeyTypes[d.KeyType.ValueString()]),
+		OCSP:          tools.BoolToString(d.OCSP.ValueBool()),
+		Automations:   automationList,
+		AutoRenewal:   tools.BoolToString(d.AutoRenewal.ValueBool()),
+		RenewInterval: tools.Int64ToString(d.RenewInterval.ValueInt64()),
+	}, nil
+}
+
+func convertAcmeCertificateStructToSchema(d *acme.Certificate) (*AcmeCertificateResourceModel, error) {
+	keyType := ""
+	for name, value := range acmeKeyTypes {
+		if value == d.KeyLength.String() {
+			keyType = name
+		}
+	}
+
+	return &AcmeCertificateResourceModel{
+		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
+		CommonName:    types.StringValue(d.Name),
+		Description:   tools.StringOrNull(d.Description),
+		AltNames:      tools.StringSliceToList(d.AltNames),
+		Account:       types.StringValue(d.Account.String()),
+		Validation:    types.StringValue(d.Validation.String()),
+		KeyType:       types.StringValue(keyType),
+		OCSP:          types.BoolValue(tools.StringToBool(d.OCSP)),
+		Automations:   tools.StringSliceToSet(d.Automations),
+		AutoRenewal:   types.BoolValue(tools.StringToBool(d.AutoRenewal)),
+		RenewInterval: types.Int64Value(tools.StringToInt64(d.RenewInterval)),
+		CertRefId:     tools.StringOrNull(d.CertRefId),
+	}, nil
+}
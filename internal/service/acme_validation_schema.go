@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/acme"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+var acmeDNSFieldRegex = regexp.MustCompile(`^dns_[a-z0-9]+_[A-Za-z0-9_]+$`)
+
+// AcmeValidationResourceModel describes the resource data model.
+type AcmeValidationResourceModel struct {
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Method            types.String `tfsdk:"method"`
+	HTTPService       types.String `tfsdk:"http_service"`
+	HTTPAutoDiscovery types.Bool   `tfsdk:"http_auto_discovery"`
+	HTTPInterface     types.String `tfsdk:"http_interface"`
+	HTTPIPAddresses   types.Set    `tfsdk:"http_ip_addresses"`
+	HAProxyFrontends  types.Set    `tfsdk:"haproxy_frontends"`
+	DNSService        types.String `tfsdk:"dns_service"`
+	DNSSleep          types.Int64  `tfsdk:"dns_sleep"`
+	DNSCredentials    types.Map    `tfsdk:"dns_credentials"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func acmeValidationResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "ACME validation methods define how the certificate authority verifies control of the domains of a certificate, either with an HTTP-01 or a DNS-01 challenge. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this validation method. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the validation method.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "Challenge type. Available values: `http01`, `dns01`. Defaults to `http01`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("http01"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("http01", "dns01"),
+				},
+			},
+			"http_service": schema.StringAttribute{
+				MarkdownDescription: "Service answering HTTP-01 challenges. Use `haproxy` to answer them from the `haproxy_frontends`. Only used when `method` is `http01`. Available values: `opnsense`, `haproxy`. Defaults to `opnsense`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("opnsense"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("opnsense", "haproxy"),
+				},
+			},
+			"http_auto_discovery": schema.BoolAttribute{
+				MarkdownDescription: "Discover the IP addresses to answer HTTP-01 challenges on from the DNS records of the domains. Only used when `http_service` is `opnsense`. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"http_interface": schema.StringAttribute{
+				MarkdownDescription: "Interface to answer HTTP-01 challenges on, e.g. `wan`. Only used when `http_service` is `opnsense`.",
+				Optional:            true,
+			},
+			"http_ip_addresses": schema.SetAttribute{
+				MarkdownDescription: "Additional IP addresses to answer HTTP-01 challenges on. Only used when `http_service` is `opnsense`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IsIP()),
+				},
+			},
+			"haproxy_frontends": schema.SetAttribute{
+				MarkdownDescription: "UUIDs of the HAProxy frontends to answer HTTP-01 challenges from. Only used when `http_service` is `haproxy`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"dns_service": schema.StringAttribute{
+				MarkdownDescription: "DNS API of the DNS provider, as named by acme.sh, e.g. `dns_cf` for Cloudflare. Required when `method` is `dns01`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^dns_[a-z0-9]+$`), "must be the name of an acme.sh DNS API, e.g. `dns_cf`"),
+				},
+			},
+			"dns_sleep": schema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to wait for DNS records to propagate before validating. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"dns_credentials": schema.MapAttribute{
+				MarkdownDescription: "Settings of the DNS API, keyed by their OPNsense field name, e.g. `{ dns_cf_token = \"...\", dns_cf_account_id = \"...\" }` for `dns_cf`. Only used when `method` is `dns01`. Defaults to `{}`.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				Default:             mapdefault.StaticValue(tools.EmptyMapValue()),
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(acmeDNSFieldRegex, "must be the OPNsense field name of a DNS API setting, e.g. `dns_cf_token`"),
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the validation method.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func AcmeValidationDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "ACME validation methods define how the certificate authority verifies control of the domains of a certificate, either with an HTTP-01 or a DNS-01 challenge. Requires the `os-acme-client` plugin.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this validation method is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the validation method.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"method": dschema.StringAttribute{
+				MarkdownDescription: "Challenge type.",
+				Computed:            true,
+			},
+			"http_service": dschema.StringAttribute{
+				MarkdownDescription: "Service answering HTTP-01 challenges.",
+				Computed:            true,
+			},
+			"http_auto_discovery": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the IP addresses to answer HTTP-01 challenges on are discovered from DNS.",
+				Computed:            true,
+			},
+			"http_interface": dschema.StringAttribute{
+				MarkdownDescription: "Interface to answer HTTP-01 challenges on.",
+				Computed:            true,
+			},
+			"http_ip_addresses": dschema.SetAttribute{
+				MarkdownDescription: "Additional IP addresses to answer HTTP-01 challenges on.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"haproxy_frontends": dschema.SetAttribute{
+				MarkdownDescription: "UUIDs of the HAProxy frontends to answer HTTP-01 challenges from.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dns_service": dschema.StringAttribute{
+				MarkdownDescription: "DNS API of the DNS provider.",
+				Computed:            true,
+			},
+			"dns_sleep": dschema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to wait for DNS records to propagate. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"dns_credentials": dschema.MapAttribute{
+				MarkdownDescription: "Settings of the DNS API, keyed by their OPNsense field name. Only settings with a value are listed.",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func validateAcmeValidationConfig(d *AcmeValidationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if d.Method.ValueString() == "dns01" && d.DNSService.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("dns_service"),
+			"Missing Attribute Configuration",
+			"Expected dns_service to be configured when method is dns01.",
+		)
+	}
+
+	return diagnostics
+}
+
+// mergeAcmeValidationState limits the DNS API settings to the ones managed
+// by the resource, since OPNsense returns the settings of all DNS APIs.
+func mergeAcmeValidationState(d *AcmeValidationResourceModel, prior *AcmeValidationResourceModel) {
+	var remote, managed map[string]string
+
+	ctx := context.Background()
+	d.DNSCredentials.ElementsAs(ctx, &remote, false)
+	prior.DNSCredentials.ElementsAs(ctx, &managed, false)
+
+	credentials := map[string]string{}
+	for k := range managed {
+		credentials[k] = remote[k]
+	}
+	d.DNSCredentials = tools.StringMapToMap(credentials)
+}
+
+func convertAcmeValidationSchemaToStruct(d *AcmeValidationResourceModel) (*acme.Validation, error) {
+	var ipList, frontendList []string
+	var credentials map[string]string
+
+	ctx := context.Background()
+	d.HTTPIPAddresses.ElementsAs(ctx, &ipList, false)
+	d.HAProxyFrontends.ElementsAs(ctx, &frontendList, false)
+	d.DNSCredentials.ElementsAs(ctx, &credentials, false)
+
+	return &acme.Validation{
+		Enabled:           tools.BoolToString(d.Enabled.ValueBool()),
+		Name:              d.Name.ValueString(),
+		Description:       d.Description.ValueString(),
+		Method:            api.SelectedMap(d.Method.ValueString()),
+		HTTPService:       api.SelectedMap(d.HTTPService.ValueString()),
+		HTTPAutoDiscovery: tools.BoolToString(d.HTTPAutoDiscovery.ValueBool()),
+		HTTPInterface:     api.SelectedMap(d.HTTPInterface.ValueString()),
+		HTTPIPAddresses:   strings.Join(ipList, ","),
+		HAProxyInject:     tools.BoolToString(len(frontendList) > 0),
+		HAProxyFrontends:  frontendList,
+		DNSService:        api.SelectedMap(d.DNSService.ValueString()),
+		DNSSleep:          tools.Int64ToStringNegative(d.DNSSleep.ValueInt64()),
+		DNSCredentials:    credentials,
+	}, nil
+}
+
+func convertAcmeValidationStructToSchema(d *acme.Validation) (*AcmeValidationResourceModel, error) {
+	return &AcmeValidationResourceModel{
+		Enabled:           types.BoolValue(tools.StringToBool(d.Enabled)),
+		Name:              types.StringValue(d.Name),
+		Description:       tools.StringOrNull(d.Description),
+		Method:            types.StringValue(d.Method.String()),
+		HTTPService:       types.StringValue(d.HTTPService.String()),
+		HTTPAutoDiscovery: types.BoolValue(tools.StringToBool(d.HTTPAutoDiscovery)),
+		HTTPInterface:     tools.StringOrNull(d.HTTPInterface.String()),
+		HTTPIPAddresses:   tools.StringSliceToSet(strings.Split(d.HTTPIPAddresses, ",")),
+		HAProxyFrontends:  tools.StringSliceToSet(d.HAProxyFrontends),
+		DNSService:        tools.StringOrNull(d.DNSService.String()),
+		DNSSleep:          types.Int64Value(tools.StringToInt64(d.DNSSleep)),
+		DNSCredentials:    tools.StringMapToMap(d.DNSCredentials),
+	}, nil
+}
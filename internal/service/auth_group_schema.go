@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/auth"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// AuthGroupResourceModel describes the resource data model.
+type AuthGroupResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Privileges  types.Set    `tfsdk:"privileges"`
+	GID         types.String `tfsdk:"gid"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func authGroupResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Local groups grant privileges to their members (see `opnsense_auth_user`).",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"privileges": schema.SetAttribute{
+				MarkdownDescription: "Privileges granted to members of the group, e.g. `page-firewall-rules` or `page-all` for full access. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"gid": schema.StringAttribute{
+				MarkdownDescription: "Group ID assigned by OPNsense, used to add users to the group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func AuthGroupDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Local groups grant privileges to their members.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the group.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"privileges": dschema.SetAttribute{
+				MarkdownDescription: "Privileges granted to members of the group.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"gid": dschema.StringAttribute{
+				MarkdownDescription: "Group ID assigned by OPNsense.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertAuthGroupSchemaToStruct(d *AuthGroupResourceModel) (*auth.Group, error) {
+	var privilegeList []string
+	d.Privileges.ElementsAs(context.Background(), &privilegeList, false)
+
+	return &auth.Group{
+		Name:        d.Name.ValueString(),
+		Description: d.Description.ValueString(),
+		Privileges:  privilegeList,
+	}, nil
+}
+
+func convertAuthGroupStructToSchema(d *auth.Group) (*AuthGroupResourceModel, error) {
+	return &AuthGroupResourceModel{
+		Name:        types.StringValue(d.Name),
+		Description: tools.StringOrNull(d.Description),
+		Privileges:  tools.StringSliceToSet(d.Privileges),
+		GID:         types.StringValue(d.GID),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuthUserAPIKeyResourceModel describes the resource data model.
+type AuthUserAPIKeyResourceModel struct {
+	User   types.String `tfsdk:"user"`
+	Key    types.String `tfsdk:"key"`
+	Secret types.String `tfsdk:"secret"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func authUserAPIKeyResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "API keys authenticate requests to the OPNsense API as a user, with the privileges of that user. The key and secret are generated by OPNsense, and can be used to configure another instance of this provider. The secret is only returned when the key is created, so API keys cannot be imported.",
+
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Name of the user (see `opnsense_auth_user`) to create the API key for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The API secret.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the API key, the same as `key`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
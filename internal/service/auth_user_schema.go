@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/auth"
+	"terraform-provider-opnsense/internal/tools"
+	"time"
+)
+
+// authExpiresLayout is the date format used by OPNsense for the expiry date
+// of users.
+const authExpiresLayout = "01/02/2006"
+
+// AuthUserResourceModel describes the resource data model.
+type AuthUserResourceModel struct {
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Name              types.String `tfsdk:"name"`
+	FullName          types.String `tfsdk:"full_name"`
+	Email             types.String `tfsdk:"email"`
+	Comment           types.String `tfsdk:"comment"`
+	Password          types.String `tfsdk:"password"`
+	ScrambledPassword types.Bool   `tfsdk:"scrambled_password"`
+	Groups            types.Set    `tfsdk:"groups"`
+	Shell             types.String `tfsdk:"shell"`
+	AuthorizedKeys    types.List   `tfsdk:"authorized_keys"`
+	Expires           types.String `tfsdk:"expires"`
+	UID               types.String `tfsdk:"uid"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func authUserResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Local users can log in to the web GUI, the console or over SSH, and own API keys (see `opnsense_auth_user_apikey`). Privileges are granted through groups.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this user. Disabled users cannot log in. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Username, used to log in.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the user.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user.",
+				Optional:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Optional comment here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user. OPNsense only stores a hash of the password, which is not returned by the API, so changes made outside of Terraform are not detected. Must not be set together with `scrambled_password`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"scrambled_password": schema.BoolAttribute{
+				MarkdownDescription: "Set a random password, which is not known to anyone, e.g. for users that only use API keys. Must not be set together with `password`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Group IDs (see the `gid` of `opnsense_auth_group`) the user is a member of. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"shell": schema.StringAttribute{
+				MarkdownDescription: "Login shell of the user, e.g. `/bin/sh` or `/usr/local/bin/bash`. Uses the OPNsense console menu when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must be an absolute path"),
+				},
+			},
+			"authorized_keys": schema.ListAttribute{
+				MarkdownDescription: "Authorized SSH public keys of the user, e.g. `ssh-ed25519 AAAA... user@host`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "Date, in `YYYY-MM-DD` format, after which the user can no longer log in. Never expires when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"uid": schema.StringAttribute{
+				MarkdownDescription: "User ID assigned by OPNsense.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func AuthUserDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Local users can log in to the web GUI, the console or over SSH, and own API keys. Privileges are granted through groups.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this user is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Username, used to log in.",
+				Computed:            true,
+			},
+			"full_name": dschema.StringAttribute{
+				MarkdownDescription: "Full name of the user.",
+				Computed:            true,
+			},
+			"email": dschema.StringAttribute{
+				MarkdownDescription: "Email address of the user.",
+				Computed:            true,
+			},
+			"comment": dschema.StringAttribute{
+				MarkdownDescription: "Optional comment here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"password": dschema.StringAttribute{
+				MarkdownDescription: "Not returned by the API, always empty.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"scrambled_password": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the user has a random password.",
+				Computed:            true,
+			},
+			"groups": dschema.SetAttribute{
+				MarkdownDescription: "Group IDs the user is a member of.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"shell": dschema.StringAttribute{
+				MarkdownDescription: "Login shell of the user.",
+				Computed:            true,
+			},
+			"authorized_keys": dschema.ListAttribute{
+				MarkdownDescription: "Authorized SSH public keys of the user.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"expires": dschema.StringAttribute{
+				MarkdownDescription: "Date, in `YYYY-MM-DD` format, after which the user can no longer log in.",
+				Computed:            true,
+			},
+			"uid": dschema.StringAttribute{
+				MarkdownDescription: "User ID assigned by OPNsense.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func validateAuthUserConfig(d *AuthUserResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if !d.Password.IsNull() && d.ScrambledPassword.ValueBool() {
+		diagnostics.AddAttributeError(
+			path.Root("scrambled_password"),
+			"Invalid Attribute Combination",
+			"Expected scrambled_password not to be true when password is configured.",
+		)
+	}
+
+	if !d.Expires.IsNull() && !d.Expires.IsUnknown() {
+		if _, err := time.Parse(time.DateOnly, d.Expires.ValueString()); err != nil {
+			diagnostics.AddAttributeError(
+				path.Root("expires"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Expected expires to be a valid date, got error: %s", err),
+			)
+		}
+	}
+
+	return diagnostics
+}
+
+func convertAuthUserSchemaToStruct(d *AuthUserResourceModel) (*auth.User, error) {
+	var groupList, keyList []string
+
+	ctx := context.Background()
+	d.Groups.ElementsAs(ctx, &groupList, false)
+	d.AuthorizedKeys.ElementsAs(ctx, &keyList, false)
+
+	// Convert expiry date to the format used by OPNsense
+	expires := ""
+	if d.Expires.ValueString() != "" {
+		date, err := time.Parse(time.DateOnly, d.Expires.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		expires = date.Format(authExpiresLayout)
+	}
+
+	return &auth.User{
+		Disabled:          tools.BoolToString(!d.Enabled.ValueBool()),
+		Name:              d.Name.ValueString(),
+		FullName:          d.FullName.ValueString(),
+		Email:             d.Email.ValueString(),
+		Comment:           d.Comment.ValueString(),
+		Password:          d.Password.ValueString(),
+		ScrambledPassword: tools.BoolToString(d.ScrambledPassword.ValueBool()),
+		Expires:           expires,
+		Shell:             api.SelectedMap(d.Shell.ValueString()),
+		AuthorizedKeys:    strings.Join(keyList, "\n"),
+		Groups:            groupList,
+	}, nil
+}
+
+func convertAuthUserStructToSchema(d *auth.User) (*AuthUserResourceModel, error) {
+	expires := ""
+	if d.Expires != "" {
+		date, err := time.Parse(authExpiresLayout, d.Expires)
+		if err != nil {
+			return nil, err
+		}
+		expires = date.Format(time.DateOnly)
+	}
+
+	// Skip blank lines between keys
+	var keyList []string
+	for _, key := range strings.Split(d.AuthorizedKeys, "\n") {
+		keyList = append(keyList, strings.TrimSpace(key))
+	}
+
+	return &AuthUserResourceModel{
+		Enabled:           types.BoolValue(!tools.StringToBool(d.Disabled)),
+		Name:              types.StringValue(d.Name),
+		FullName:          tools.StringOrNull(d.FullName),
+		Email:             tools.StringOrNull(d.Email),
+		Comment:           tools.StringOrNull(d.Comment),
+		Password:          types.StringNull(),
+		ScrambledPassword: types.BoolValue(tools.StringToBool(d.ScrambledPassword)),
+		Groups:            tools.StringSliceToSet(d.Groups),
+		Shell:             tools.StringOrNull(d.Shell.String()),
+		AuthorizedKeys:    tools.StringSliceToList(keyList),
+		Expires:           tools.StringOrNull(expires),
+		UID:               types.StringValue(d.UID),
+	}, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/cron"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+var cronFieldRegex = regexp.MustCompile(`^(\*|[0-9]+(-[0-9]+)?)(/[0-9]+)?(,(\*|[0-9]+(-[0-9]+)?)(/[0-9]+)?)*$`)
+
+// CronJobResourceModel describes the resource data model.
+type CronJobResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Minutes     types.String `tfsdk:"minutes"`
+	Hours       types.String `tfsdk:"hours"`
+	Days        types.String `tfsdk:"days"`
+	Months      types.String `tfsdk:"months"`
+	Weekdays    types.String `tfsdk:"weekdays"`
+	Command     types.String `tfsdk:"command"`
+	Parameters  types.String `tfsdk:"parameters"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func cronJobResourceSchema() schema.Schema {
+	cronFieldValidator := stringvalidator.RegexMatches(cronFieldRegex, "must be a cron schedule field, e.g. `*`, `5`, `1-5`, `*/15` or `0,30`")
+
+	return schema.Schema{
+		MarkdownDescription: "Cron jobs run configd actions on a schedule, e.g. updating URL table aliases, checking for firmware updates or backing up the configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this cron job. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"minutes": schema.StringAttribute{
+				MarkdownDescription: "Minutes (0-59) to run the job at. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0"),
+				Validators:          []validator.String{cronFieldValidator},
+			},
+			"hours": schema.StringAttribute{
+				MarkdownDescription: "Hours (0-23) to run the job at. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0"),
+				Validators:          []validator.String{cronFieldValidator},
+			},
+			"days": schema.StringAttribute{
+				MarkdownDescription: "Days of the month (1-31) to run the job on. Defaults to `*`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("*"),
+				Validators:          []validator.String{cronFieldValidator},
+			},
+			"months": schema.StringAttribute{
+				MarkdownDescription: "Months (1-12) to run the job in. Defaults to `*`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("*"),
+				Validators:          []validator.String{cronFieldValidator},
+			},
+			"weekdays": schema.StringAttribute{
+				MarkdownDescription: "Days of the week (0-7, 0 and 7 are Sunday) to run the job on. Defaults to `*`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("*"),
+				Validators:          []validator.String{cronFieldValidator},
+			},
+			"command": schema.StringAttribute{
+				MarkdownDescription: "Configd action to run, e.g. `filter refresh_aliases` or `firmware auto-update`. Must be one of the actions offered by the OPNsense host, which is checked when planning.",
+				Required:            true,
+			},
+			"parameters": schema.StringAttribute{
+				MarkdownDescription: "Parameters passed to the command.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the cron job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func CronJobDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Cron jobs run configd actions on a schedule, e.g. updating URL table aliases, checking for firmware updates or backing up the configuration.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this cron job is enabled.",
+				Computed:            true,
+			},
+			"minutes": dschema.StringAttribute{
+				MarkdownDescription: "Minutes to run the job at.",
+				Computed:            true,
+			},
+			"hours": dschema.StringAttribute{
+				MarkdownDescription: "Hours to run the job at.",
+				Computed:            true,
+			},
+			"days": dschema.StringAttribute{
+				MarkdownDescription: "Days of the month to run the job on.",
+				Computed:            true,
+			},
+			"months": dschema.StringAttribute{
+				MarkdownDescription: "Months to run the job in.",
+				Computed:            true,
+			},
+			"weekdays": dschema.StringAttribute{
+				MarkdownDescription: "Days of the week to run the job on.",
+				Computed:            true,
+			},
+			"command": dschema.StringAttribute{
+				MarkdownDescription: "Configd action to run.",
+				Computed:            true,
+			},
+			"parameters": dschema.StringAttribute{
+				MarkdownDescription: "Parameters passed to the command.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertCronJobSchemaToStruct(d *CronJobResourceModel) (*cron.Job, error) {
+	return &cron.Job{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Minutes:     d.Minutes.ValueString(),
+		Hours:       d.Hours.ValueString(),
+		Days:        d.Days.ValueString(),
+		Months:      d.Months.ValueString(),
+		Weekdays:    d.Weekdays.ValueString(),
+		Command:     api.SelectedMap(d.Command.ValueString()),
+		Parameters:  d.Parameters.ValueString(),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertCronJobStructToSchema(d *cron.Job) (*CronJobResourceModel, error) {
+	return &CronJobResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Minutes:     types.StringValue(d.Minutes),
+		Hours:       types.StringValue(d.Hours),
+		Days:        types.StringValue(d.Days),
+		Months:      types.StringValue(d.Months),
+		Weekdays:    types.StringValue(d.Weekdays),
+		Command:     types.StringValue(d.Command.String()),
+		Parameters:  tools.StringOrNull(d.Parameters),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/netip"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/dhcpv4"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type dhcpLease struct {
+	Address     types.String `tfsdk:"address"`
+	MACAddress  types.String `tfsdk:"mac_address"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Interface   types.String `tfsdk:"interface"`
+	Type        types.String `tfsdk:"type"`
+	Status      types.String `tfsdk:"status"`
+	State       types.String `tfsdk:"state"`
+	Starts      types.String `tfsdk:"starts"`
+	Ends        types.String `tfsdk:"ends"`
+	Description types.String `tfsdk:"description"`
+}
+
+// DhcpLeasesDataSourceModel describes the data source data model.
+type DhcpLeasesDataSourceModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Leases    []dhcpLease  `tfsdk:"leases"`
+}
+
+func DhcpLeasesDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Lists the active leases of the ISC DHCPv4 server. Static mappings are listed as leases with type `static`.\n\n-> The ISC DHCP static mappings are not exposed by the OPNsense API, so they cannot be managed by this provider. To pin the address of a device, use `opnsense_kea_dhcpv4_reservation` instead.",
+
+		Attributes: map[string]dschema.Attribute{
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "Only list leases on this interface, e.g. `lan` or `opt1`. Lists leases on all interfaces when not set.",
+				Optional:            true,
+			},
+			"leases": dschema.ListNestedAttribute{
+				MarkdownDescription: "List of leases, sorted by `interface` and `address`.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"address": dschema.StringAttribute{
+							MarkdownDescription: "IP address leased to the client.",
+							Computed:            true,
+						},
+						"mac_address": dschema.StringAttribute{
+							MarkdownDescription: "MAC address of the client.",
+							Computed:            true,
+						},
+						"hostname": dschema.StringAttribute{
+							MarkdownDescription: "Hostname of the client.",
+							Computed:            true,
+						},
+						"interface": dschema.StringAttribute{
+							MarkdownDescription: "Interface the lease was handed out on, e.g. `lan`.",
+							Computed:            true,
+						},
+						"type": dschema.StringAttribute{
+							MarkdownDescription: "Type of lease. Available values: `dynamic`, `static`.",
+							Computed:            true,
+						},
+						"status": dschema.StringAttribute{
+							MarkdownDescription: "Whether the client is currently reachable. Available values: `online`, `offline`.",
+							Computed:            true,
+						},
+						"state": dschema.StringAttribute{
+							MarkdownDescription: "Binding state of the lease, e.g. `active`.",
+							Computed:            true,
+						},
+						"starts": dschema.StringAttribute{
+							MarkdownDescription: "Start of the lease, as reported by OPNsense.",
+							Computed:            true,
+						},
+						"ends": dschema.StringAttribute{
+							MarkdownDescription: "End of the lease, as reported by OPNsense.",
+							Computed:            true,
+						},
+						"description": dschema.StringAttribute{
+							MarkdownDescription: "Description of the static mapping.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertDhcpLeasesStructToSchema(iface types.String, leases []dhcpv4.Lease) (*DhcpLeasesDataSourceModel, error) {
+	model := &DhcpLeasesDataSourceModel{
+		Interface: iface,
+		Leases:    []dhcpLease{},
+	}
+
+	for _, lease := range leases {
+		if !iface.IsNull() && lease.Interface != iface.ValueString() {
+			continue
+		}
+
+		// Prefer the hostname sent by the client
+		hostname := lease.ClientHostname
+		if hostname == "" {
+			hostname = lease.Hostname
+		}
+
+		model.Leases = append(model.Leases, dhcpLease{
+			Address:     types.StringValue(lease.Address),
+			MACAddress:  types.StringValue(lease.MAC),
+			Hostname:    tools.StringOrNull(hostname),
+			Interface:   types.StringValue(lease.Interface),
+			Type:        types.StringValue(lease.Type),
+			Status:      types.StringValue(lease.Status),
+			State:       tools.StringOrNull(lease.State),
+			Starts:      tools.StringOrNull(lease.Starts),
+			Ends:        tools.StringOrNull(lease.Ends),
+			Description: tools.StringOrNull(lease.Description),
+		})
+	}
+
+	sort.SliceStable(model.Leases, func(i, j int) bool {
+		a, b := model.Leases[i], model.Leases[j]
+		if a.Interface.ValueString() != b.Interface.ValueString() {
+			return a.Interface.ValueString() < b.Interface.ValueString()
+		}
+
+		// Compare addresses numerically, so that .2 is listed before .10
+		addrA, errA := netip.ParseAddr(a.Address.ValueString())
+		addrB, errB := netip.ParseAddr(b.Address.ValueString())
+		if errA != nil || errB != nil {
+			return a.Address.ValueString() < b.Address.ValueString()
+		}
+		return addrA.Less(addrB)
+	})
+
+	return model, nil
+}
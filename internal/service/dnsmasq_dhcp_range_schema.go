@@ -0,0 +1,154 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// DnsmasqDhcpRangeResourceModel describes the resource data model.
+type DnsmasqDhcpRangeResourceModel struct {
+	Interface    types.String `tfsdk:"interface"`
+	StartAddress types.String `tfsdk:"start_address"`
+	EndAddress   types.String `tfsdk:"end_address"`
+	SubnetMask   types.String `tfsdk:"subnet_mask"`
+	Domain       types.String `tfsdk:"domain"`
+	LeaseTime    types.Int64  `tfsdk:"lease_time"`
+	Description  types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqDhcpRangeResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "DHCP ranges define the pools of addresses Dnsmasq hands out to DHCP clients.\n\n-> Requires OPNsense 25.1 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface to serve this range on, e.g. `lan` or `opt1`. When not set, the range is served on any interface with a matching subnet.",
+				Optional:            true,
+			},
+			"start_address": schema.StringAttribute{
+				MarkdownDescription: "First address of the range, e.g. `192.168.1.100`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IsIP(),
+				},
+			},
+			"end_address": schema.StringAttribute{
+				MarkdownDescription: "Last address of the range, e.g. `192.168.1.200`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsIP(),
+				},
+			},
+			"subnet_mask": schema.StringAttribute{
+				MarkdownDescription: "Subnet mask of the range, e.g. `255.255.255.0`. Only needed when it cannot be derived from the interface.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsIP(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain offered to clients in this range, e.g. `lan.example.com`.",
+				Optional:            true,
+			},
+			"lease_time": schema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds. Set to `-1` to use the Dnsmasq default of one hour. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the DHCP range.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqDhcpRangeDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "DHCP ranges define the pools of addresses Dnsmasq hands out to DHCP clients.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "Interface this range is served on.",
+				Computed:            true,
+			},
+			"start_address": dschema.StringAttribute{
+				MarkdownDescription: "First address of the range.",
+				Computed:            true,
+			},
+			"end_address": dschema.StringAttribute{
+				MarkdownDescription: "Last address of the range.",
+				Computed:            true,
+			},
+			"subnet_mask": dschema.StringAttribute{
+				MarkdownDescription: "Subnet mask of the range.",
+				Computed:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain offered to clients in this range.",
+				Computed:            true,
+			},
+			"lease_time": dschema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds. `-1` if the Dnsmasq default is used.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDnsmasqDhcpRangeSchemaToStruct(d *DnsmasqDhcpRangeResourceModel) (*dnsmasq.Range, error) {
+	return &dnsmasq.Range{
+		Interface:   api.SelectedMap(d.Interface.ValueString()),
+		StartAddr:   d.StartAddress.ValueString(),
+		EndAddr:     d.EndAddress.ValueString(),
+		SubnetMask:  d.SubnetMask.ValueString(),
+		Domain:      d.Domain.ValueString(),
+		LeaseTime:   tools.Int64ToStringNegative(d.LeaseTime.ValueInt64()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertDnsmasqDhcpRangeStructToSchema(d *dnsmasq.Range) (*DnsmasqDhcpRangeResourceModel, error) {
+	model := &DnsmasqDhcpRangeResourceModel{
+		Interface:    tools.StringOrNull(d.Interface.String()),
+		StartAddress: types.StringValue(d.StartAddr),
+		EndAddress:   tools.StringOrNull(d.EndAddr),
+		SubnetMask:   tools.StringOrNull(d.SubnetMask),
+		Domain:       tools.StringOrNull(d.Domain),
+		LeaseTime:    types.Int64Value(-1),
+		Description:  tools.StringOrNull(d.Description),
+	}
+
+	if d.LeaseTime != "" {
+		model.LeaseTime = types.Int64Value(tools.StringToInt64(d.LeaseTime))
+	}
+
+	return model, nil
+}
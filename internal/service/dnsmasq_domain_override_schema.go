@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// DnsmasqDomainOverrideResourceModel describes the resource data model.
+type DnsmasqDomainOverrideResourceModel struct {
+	Domain      types.String `tfsdk:"domain"`
+	Server      types.String `tfsdk:"server"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqDomainOverrideResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Domain overrides can be used to forward queries for specific domains (and subsequent subdomains) to local or remote DNS servers.\n\n-> Requires OPNsense 25.1 or later. Unlike `opnsense_unbound_domain_override`, Dnsmasq domain overrides cannot be disabled, so there is no `enabled` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain to override (NOTE: this does not have to be a valid TLD!), e.g. `test` or `mycompany.localdomain` or `1.168.192.in-addr.arpa`.",
+				Required:            true,
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "IP address of the authoritative DNS server for this domain, e.g. `192.168.100.100`. To use a nondefault port for communication, append an `@` with the port number.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the domain override.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqDomainOverrideDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Domain overrides can be used to forward queries for specific domains (and subsequent subdomains) to local or remote DNS servers.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain to override (NOTE: this does not have to be a valid TLD!), e.g. `test` or `mycompany.localdomain` or `1.168.192.in-addr.arpa`.",
+				Computed:            true,
+			},
+			"server": dschema.StringAttribute{
+				MarkdownDescription: "IP address of the authoritative DNS server for this domain, e.g. `192.168.100.100`, followed by `@` and the port number if a nondefault port is used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDnsmasqDomainOverrideSchemaToStruct(d *DnsmasqDomainOverrideResourceModel) (*dnsmasq.Domain, error) {
+	// Dnsmasq stores the port separately, split it off the server
+	ip, port, _ := strings.Cut(d.Server.ValueString(), "@")
+
+	return &dnsmasq.Domain{
+		Domain:      d.Domain.ValueString(),
+		IP:          ip,
+		Port:        port,
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertDnsmasqDomainOverrideStructToSchema(d *dnsmasq.Domain) (*DnsmasqDomainOverrideResourceModel, error) {
+	server := d.IP
+	if d.Port != "" {
+		server = fmt.Sprintf("%s@%s", d.IP, d.Port)
+	}
+
+	return &DnsmasqDomainOverrideResourceModel{
+		Domain:      types.StringValue(d.Domain),
+		Server:      types.StringValue(server),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
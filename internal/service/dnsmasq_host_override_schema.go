@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/apiutil"
+	"terraform-provider-opnsense/internal/opnsense/dnsmasq"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// DnsmasqHostOverrideResourceModel describes the resource data model.
+type DnsmasqHostOverrideResourceModel struct {
+	Hostname     types.String `tfsdk:"hostname"`
+	Domain       types.String `tfsdk:"domain"`
+	Server       types.String `tfsdk:"server"`
+	MACAddresses types.Set    `tfsdk:"mac_addresses"`
+	ClientId     types.String `tfsdk:"client_id"`
+	LeaseTime    types.Int64  `tfsdk:"lease_time"`
+	Description  types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func dnsmasqHostOverrideResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Host overrides can be used to change DNS results from client queries. When `mac_addresses` or `client_id` are set, the host is also used as a static DHCP host, which is offered the address in `server`.\n\n-> Requires OPNsense 25.1 or later. Unlike `opnsense_unbound_host_override`, Dnsmasq hosts cannot be disabled, so there is no `enabled` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Name of the host, without the domain part.",
+				Required:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain of the host, e.g. example.com",
+				Required:            true,
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "IP address of the host, e.g. 192.168.100.100 or fd00:abcd::1.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsIP(),
+				},
+			},
+			"mac_addresses": schema.SetAttribute{
+				MarkdownDescription: "MAC addresses of the host, used to offer it a static DHCP lease. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IsMAC()),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "DHCP client identifier of the host, used instead of (or together with) the MAC address.",
+				Optional:            true,
+			},
+			"lease_time": schema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds of the static DHCP lease. Set to `-1` to use the lease time of the range. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the host override.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func DnsmasqHostOverrideDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Host overrides can be used to change DNS results from client queries. Hosts with MAC addresses or a client identifier are also used as static DHCP hosts.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"hostname": dschema.StringAttribute{
+				MarkdownDescription: "Name of the host, without the domain part.",
+				Computed:            true,
+			},
+			"domain": dschema.StringAttribute{
+				MarkdownDescription: "Domain of the host, e.g. example.com",
+				Computed:            true,
+			},
+			"server": dschema.StringAttribute{
+				MarkdownDescription: "IP address of the host, e.g. 192.168.100.100 or fd00:abcd::1.",
+				Computed:            true,
+			},
+			"mac_addresses": dschema.SetAttribute{
+				MarkdownDescription: "MAC addresses of the host.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"client_id": dschema.StringAttribute{
+				MarkdownDescription: "DHCP client identifier of the host.",
+				Computed:            true,
+			},
+			"lease_time": dschema.Int64Attribute{
+				MarkdownDescription: "Lease time in seconds of the static DHCP lease. `-1` if the lease time of the range is used.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertDnsmasqHostOverrideSchemaToStruct(d *DnsmasqHostOverrideResourceModel) (*dnsmasq.Host, error) {
+	// Parse 'MACAddresses'
+	var macList []string
+	d.MACAddresses.ElementsAs(context.Background(), &macList, false)
+
+	// Parse 'Server'
+	ipList := apiutil.OrderedList{}
+	if d.Server.ValueString() != "" {
+		ipList = apiutil.OrderedList{d.Server.ValueString()}
+	}
+
+	return &dnsmasq.Host{
+		Host:        d.Hostname.ValueString(),
+		Domain:      d.Domain.ValueString(),
+		IP:          ipList,
+		HWAddr:      macList,
+		ClientId:    d.ClientId.ValueString(),
+		LeaseTime:   tools.Int64ToStringNegative(d.LeaseTime.ValueInt64()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertDnsmasqHostOverrideStructToSchema(d *dnsmasq.Host) (*DnsmasqHostOverrideResourceModel, error) {
+	model := &DnsmasqHostOverrideResourceModel{
+		Hostname:     types.StringValue(d.Host),
+		Domain:       types.StringValue(d.Domain),
+		Server:       tools.StringOrNull(strings.Join(d.IP, ",")),
+		MACAddresses: tools.StringSliceToSet(d.HWAddr),
+		ClientId:     tools.StringOrNull(d.ClientId),
+		LeaseTime:    types.Int64Value(-1),
+		Description:  tools.StringOrNull(d.Description),
+	}
+
+	if d.LeaseTime != "" {
+		model.LeaseTime = types.Int64Value(tools.StringToInt64(d.LeaseTime))
+	}
+
+	return model, nil
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ExclusiveResource{}
+var _ resource.ResourceWithModifyPlan = &ExclusiveResource{}
+
+// ExclusiveResource defines the resource implementation, shared by the
+// exclusive resources of each kind of object.
+type ExclusiveResource struct {
+	client opnsense.Client
+	kind   *exclusiveKind
+}
+
+func (r *ExclusiveResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.kind.typeName + "_exclusive"
+}
+
+func (r *ExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = exclusiveResourceSchema(r.kind)
+}
+
+func (r *ExclusiveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *ExclusiveResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data *ExclusiveResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The managed objects may not exist yet, in which case they are compared at apply time
+	if data.ManagedIds.IsUnknown() || data.IgnoredIds.IsUnknown() || data.DeleteUnmanaged.IsUnknown() {
+		data.Unmanaged = types.MapUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		return
+	}
+
+	unmanaged, diags := r.getUnmanaged(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(unmanaged) > 0 {
+		if data.DeleteUnmanaged.ValueBool() {
+			resp.Diagnostics.AddWarning("Unmanaged Objects Will Be Deleted",
+				fmt.Sprintf("The following %s are not managed by Terraform and will be deleted: %s",
+					r.kind.plural, exclusiveObjectsString(unmanaged)))
+		} else {
+			resp.Diagnostics.AddWarning("Unmanaged Objects",
+				fmt.Sprintf("The following %s are not managed by Terraform: %s. "+
+					"Set delete_unmanaged to true to delete them, or add them to ignored_ids to keep them.",
+					r.kind.plural, exclusiveObjectsString(unmanaged)))
+		}
+	}
+
+	// Unmanaged objects are deleted on apply
+	if data.DeleteUnmanaged.ValueBool() {
+		unmanaged = map[string]string{}
+	}
+
+	unmanagedMap, diags := types.MapValueFrom(ctx, types.StringType, unmanaged)
+	resp.Diagnostics.Append(diags...)
+	data.Unmanaged = unmanagedMap
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+func (r *ExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ExclusiveResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete or record the unmanaged objects
+	resp.Diagnostics.Append(r.reconcile(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tag new resource with the type of the objects
+	data.Id = types.StringValue(r.kind.typeName)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ExclusiveResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unmanaged, diags := r.getUnmanaged(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unmanagedMap, diags := types.MapValueFrom(ctx, types.StringType, unmanaged)
+	resp.Diagnostics.Append(diags...)
+	data.Unmanaged = unmanagedMap
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ExclusiveResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete or record the unmanaged objects
+	resp.Diagnostics.Append(r.reconcile(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExclusiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Objects are left in place, the resource only stops checking them
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+// getUnmanaged lists all objects of the kind, and returns the ones that are
+// neither managed nor ignored.
+func (r *ExclusiveResource) getUnmanaged(ctx context.Context, data *ExclusiveResourceModel) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objects, err := r.kind.list(ctx, r.client)
+	if err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to list %s, got error: %s", r.kind.plural, err))
+		return nil, diags
+	}
+
+	return exclusiveUnmanagedObjects(objects, data), diags
+}
+
+// reconcile deletes the unmanaged objects when delete_unmanaged is set, and
+// records the unmanaged objects not known at plan time.
+func (r *ExclusiveResource) reconcile(ctx context.Context, data *ExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.DeleteUnmanaged.ValueBool() && !data.Unmanaged.IsUnknown() {
+		return diags
+	}
+
+	unmanaged, diags := r.getUnmanaged(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if data.DeleteUnmanaged.ValueBool() {
+		for id, name := range unmanaged {
+			tflog.Info(ctx, fmt.Sprintf("deleting unmanaged %s %s (%s)", r.kind.noun, name, id))
+
+			if err := r.kind.delete(ctx, r.client, id); err != nil {
+				diags.AddAttributeError(path.Root("delete_unmanaged"), "Client Error",
+					fmt.Sprintf("Unable to delete %s %s, got error: %s", r.kind.noun, name, err))
+				return diags
+			}
+		}
+		unmanaged = map[string]string{}
+	}
+
+	unmanagedMap, d := types.MapValueFrom(ctx, types.StringType, unmanaged)
+	diags.Append(d...)
+	data.Unmanaged = unmanagedMap
+
+	return diags
+}
+
+// exclusiveObjectsString formats objects as a list of `name (UUID)`, sorted
+// by name.
+func exclusiveObjectsString(objects map[string]string) string {
+	var list []string
+	for id, name := range objects {
+		list = append(list, fmt.Sprintf("%s (%s)", name, id))
+	}
+	sort.Strings(list)
+	return strings.Join(list, ", ")
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// exclusiveObject is an object of the type owned by an exclusive resource,
+// with a human-readable name to report it by.
+type exclusiveObject struct {
+	Id   string
+	Name string
+}
+
+// exclusiveKind describes the type of objects owned by an exclusive resource.
+type exclusiveKind struct {
+	// typeName is the name of the resource managing a single object, without
+	// the provider prefix (e.g. `firewall_alias`).
+	typeName string
+	noun     string
+	plural   string
+
+	// note is appended to the description of the resource.
+	note string
+
+	// list returns all objects of the type, except the ones owned by the
+	// system, which must never be deleted.
+	list   func(ctx context.Context, c opnsense.Client) ([]exclusiveObject, error)
+	delete func(ctx context.Context, c opnsense.Client, id string) error
+}
+
+// ExclusiveResourceModel describes the resource data model.
+type ExclusiveResourceModel struct {
+	ManagedIds      types.Set  `tfsdk:"managed_ids"`
+	IgnoredIds      types.Set  `tfsdk:"ignored_ids"`
+	DeleteUnmanaged types.Bool `tfsdk:"delete_unmanaged"`
+	Unmanaged       types.Map  `tfsdk:"unmanaged"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func exclusiveResourceSchema(kind *exclusiveKind) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Ensures OPNsense only contains the %[1]s managed by Terraform. All %[1]s are compared against the UUIDs of the managed ones (see `opnsense_%[2]s`), and any other %[3]s is reported, or deleted when `delete_unmanaged` is set. Only one of these resources should be declared.%[4]s", kind.plural, kind.typeName, kind.noun, kind.note),
+
+		Attributes: map[string]schema.Attribute{
+			"managed_ids": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("UUIDs of the managed %s, usually the `id` of `opnsense_%s` resources.", kind.plural, kind.typeName),
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"ignored_ids": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("UUIDs of unmanaged %s to keep, e.g. the ones managed outside of Terraform. Defaults to `[]`.", kind.plural),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Delete the unmanaged %s. When `false`, they are only reported in `unmanaged`. Defaults to `false`.", kind.plural),
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"unmanaged": schema.MapAttribute{
+				MarkdownDescription: fmt.Sprintf("Unmanaged %s found in OPNsense, by UUID. Values are the name of each %s.", kind.plural, kind.noun),
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the resource type the objects are managed by.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// exclusiveUnmanagedObjects returns the names of the objects that are neither
+// managed nor ignored, by UUID.
+func exclusiveUnmanagedObjects(objects []exclusiveObject, d *ExclusiveResourceModel) map[string]string {
+	var managedList, ignoredList []string
+	d.ManagedIds.ElementsAs(context.Background(), &managedList, false)
+	d.IgnoredIds.ElementsAs(context.Background(), &ignoredList, false)
+
+	known := map[string]bool{}
+	for _, id := range append(managedList, ignoredList...) {
+		known[id] = true
+	}
+
+	unmanaged := map[string]string{}
+	for _, object := range objects {
+		if !known[object.Id] {
+			unmanaged[object.Id] = object.Name
+		}
+	}
+	return unmanaged
+}
@@ -47,7 +47,7 @@ func (d *FirewallAliasDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *FirewallAliasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *FirewallAliasResourceModel
+	var data *FirewallAliasDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -72,9 +72,23 @@ func (d *FirewallAliasDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	// ID cannot be added by convert... func, have to add here
-	resourceModel.Id = data.Id
+	dataSourceModel := &FirewallAliasDataSourceModel{
+		Enabled:     resourceModel.Enabled,
+		Name:        resourceModel.Name,
+		Type:        resourceModel.Type,
+		IPProtocol:  resourceModel.IPProtocol,
+		Interface:   resourceModel.Interface,
+		Content:     resourceModel.Content,
+		ContentHash: resourceModel.ContentHash,
+		Categories:  resourceModel.Categories,
+		UpdateFreq:  resourceModel.UpdateFreq,
+		Statistics:  resourceModel.Statistics,
+		Description: resourceModel.Description,
+
+		// ID cannot be added by convert... func, have to add here
+		Id: data.Id,
+	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &dataSourceModel)...)
 }
@@ -0,0 +1,41 @@
+package service
+
+import (
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// FirewallAliasEntriesDataSourceModel describes the data source data model.
+type FirewallAliasEntriesDataSourceModel struct {
+	Alias   types.String `tfsdk:"alias"`
+	Entries types.List   `tfsdk:"entries"`
+}
+
+func FirewallAliasEntriesDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Lists the addresses currently loaded in the pf table of an alias. Unlike `content`, this includes the addresses resolved at runtime, e.g. the contents of `urltable` and `geoip` aliases, or the addresses added to `external` aliases (see `opnsense_firewall_alias_entry`).",
+
+		Attributes: map[string]dschema.Attribute{
+			"alias": dschema.StringAttribute{
+				MarkdownDescription: "Name of the alias (see `opnsense_firewall_alias`).",
+				Required:            true,
+			},
+			"entries": dschema.ListAttribute{
+				MarkdownDescription: "Addresses and networks in the table of the alias, sorted.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func convertFirewallAliasEntriesStructToSchema(alias types.String, entries []string) *FirewallAliasEntriesDataSourceModel {
+	sort.Strings(entries)
+
+	return &FirewallAliasEntriesDataSourceModel{
+		Alias:   alias,
+		Entries: tools.StringSliceToList(entries),
+	}
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallAliasEntryResource{}
+var _ resource.ResourceWithImportState = &FirewallAliasEntryResource{}
+
+func NewFirewallAliasEntryResource() resource.Resource {
+	return &FirewallAliasEntryResource{}
+}
+
+// FirewallAliasEntryResource defines the resource implementation.
+type FirewallAliasEntryResource struct {
+	client opnsense.Client
+}
+
+func (r *FirewallAliasEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_alias_entry"
+}
+
+func (r *FirewallAliasEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = firewallAliasEntryResourceSchema()
+}
+
+func (r *FirewallAliasEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+// checkExternalAlias ensures the alias named name exists and is an external
+// alias.
+func (r *FirewallAliasEntryResource) checkExternalAlias(ctx context.Context, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rows, err := r.client.Firewall().SearchAliases(ctx)
+	if err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to search firewall aliases, got error: %s", err))
+		return diags
+	}
+
+	for _, row := range rows {
+		if row.Name != name {
+			continue
+		}
+
+		// The search endpoint returns the description of the type, so the
+		// alias is fetched to get the type itself
+		alias, err := r.client.Firewall().GetAlias(ctx, row.Id)
+		if err != nil {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to read firewall alias, got error: %s", err))
+			return diags
+		}
+
+		if alias.Type.String() != "external" {
+			diags.AddAttributeError(path.Root("alias"), "Invalid Attribute Value",
+				fmt.Sprintf("Alias %s has type %s, entries can only be added to aliases with type external.", name, alias.Type.String()))
+		}
+		return diags
+	}
+
+	diags.AddAttributeError(path.Root("alias"), "Invalid Attribute Value",
+		fmt.Sprintf("Alias %s does not exist.", name))
+	return diags
+}
+
+func (r *FirewallAliasEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallAliasEntryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Entries of other aliases are lost when their table is reloaded
+	resp.Diagnostics.Append(r.checkExternalAlias(ctx, data.Alias.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add address to the alias table
+	err := r.client.Firewall().AddAliasEntry(ctx, data.Alias.ValueString(), data.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create alias entry, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Alias.ValueString(), data.Address.ValueString()))
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAliasEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallAliasEntryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get alias table from OPNsense API
+	entries, err := r.client.Firewall().ListAliasEntries(ctx, data.Alias.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read alias entries, got error: %s", err))
+		return
+	}
+
+	// Tables are flushed on reboot, in which case the entry is added again
+	if !firewallAliasEntryPresent(entries, data.Address.ValueString()) {
+		tflog.Warn(ctx, fmt.Sprintf("alias entry not present in remote, removing from state"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAliasEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallAliasEntryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes require replacement, nothing to update
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAliasEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallAliasEntryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Firewall().DeleteAliasEntry(ctx, data.Alias.ValueString(), data.Address.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete alias entry, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallAliasEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Alias names cannot contain `/`, so the address is everything after the first one
+	alias, address, ok := strings.Cut(req.ID, "/")
+	if !ok || alias == "" || address == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <alias>/<address>, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alias"), alias)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), address)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
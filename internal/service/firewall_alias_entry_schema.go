@@ -0,0 +1,82 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/netip"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// FirewallAliasEntryResourceModel describes the resource data model.
+type FirewallAliasEntryResourceModel struct {
+	Alias   types.String `tfsdk:"alias"`
+	Address types.String `tfsdk:"address"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func firewallAliasEntryResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Adds an address to the pf table of an `external` alias, without changing the alias itself, e.g. to block an address from an incident response workflow. Entries are not stored in the OPNsense configuration, so they are lost on reboot and added again on the next apply. Entries can be imported by `<alias>/<address>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "Name of the alias (see `opnsense_firewall_alias`). Must be an alias with `type = \"external\"`, since the tables of other aliases are reloaded from their content.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "IP address or network in CIDR notation to add to the alias (e.g. `192.0.2.1` or `198.51.100.0/24`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.Any(validators.IsIP(), validators.IsCIDR()),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the entry, as `<alias>/<address>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// firewallAliasEntryPrefix returns address as a network, since pf lists
+// single addresses without their prefix length, and networks by their first
+// address.
+func firewallAliasEntryPrefix(address string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(address); err == nil {
+		return prefix.Masked(), true
+	}
+	if addr, err := netip.ParseAddr(address); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), true
+	}
+	return netip.Prefix{}, false
+}
+
+// firewallAliasEntryPresent returns whether address is in entries, comparing
+// them as networks.
+func firewallAliasEntryPresent(entries []string, address string) bool {
+	want, ok := firewallAliasEntryPrefix(address)
+	if !ok {
+		return false
+	}
+
+	for _, entry := range entries {
+		if prefix, ok := firewallAliasEntryPrefix(entry); ok && prefix == want {
+			return true
+		}
+	}
+	return false
+}
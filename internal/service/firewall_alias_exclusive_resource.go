@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// firewallAliasSystemTypes are the types of the aliases owned by OPNsense or
+// its plugins, which are filled at runtime. The search endpoint returns the
+// description of the type (e.g. `Internal (automatic)`), so only its first
+// word is compared.
+var firewallAliasSystemTypes = map[string]bool{
+	"internal": true,
+	"external": true,
+}
+
+// firewallAliasSystemNames are the aliases created by OPNsense itself, in case
+// their type does not identify them.
+var firewallAliasSystemNames = map[string]bool{
+	"bogons":     true,
+	"bogonsv6":   true,
+	"sshlockout": true,
+	"virusprot":  true,
+}
+
+func NewFirewallAliasExclusiveResource() resource.Resource {
+	return &ExclusiveResource{kind: &exclusiveKind{
+		typeName: "firewall_alias",
+		noun:     "alias",
+		plural:   "aliases",
+		note:     " Internal and external aliases are never reported, since they are owned by OPNsense and its plugins.",
+		list: func(ctx context.Context, c opnsense.Client) ([]exclusiveObject, error) {
+			rows, err := c.Firewall().SearchAliases(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var objects []exclusiveObject
+			for _, row := range rows {
+				// Skip built-in aliases, and the ones generated for interfaces (e.g. `__lan_network`)
+				aliasType, _, _ := strings.Cut(strings.ToLower(row.Type), " ")
+				if row.Id == "" || firewallAliasSystemTypes[aliasType] || firewallAliasSystemNames[row.Name] || strings.HasPrefix(row.Name, "__") {
+					continue
+				}
+				objects = append(objects, exclusiveObject{Id: row.Id, Name: row.Name})
+			}
+			return objects, nil
+		},
+		delete: func(ctx context.Context, c opnsense.Client, id string) error {
+			return c.Firewall().DeleteAlias(ctx, id)
+		},
+	}}
+}
@@ -6,16 +6,23 @@ import (
 	"fmt"
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io/fs"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &FirewallAliasResource{}
 var _ resource.ResourceWithImportState = &FirewallAliasResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallAliasResource{}
+var _ resource.ResourceWithModifyPlan = &FirewallAliasResource{}
 
 func NewFirewallAliasResource() resource.Resource {
 	return &FirewallAliasResource{}
@@ -52,6 +59,98 @@ func (r *FirewallAliasResource) Configure(ctx context.Context, req resource.Conf
 	r.client = opnsense.NewClient(apiClient)
 }
 
+func (r *FirewallAliasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *FirewallAliasResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateFirewallAliasConfig(data)...)
+}
+
+func (r *FirewallAliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state *FirewallAliasResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The content may not be known yet, in which case it is hashed at apply time
+	if data.Content.IsUnknown() || data.ContentFile.IsUnknown() {
+		data.ContentHash = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		return
+	}
+
+	content, err := firewallAliasContent(data)
+	if errors.Is(err, fs.ErrNotExist) && !data.ContentFile.IsNull() {
+		// The content file may be created by this apply, so is read at apply time
+		data.ContentHash = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Invalid Attribute Value",
+			fmt.Sprintf("Unable to read content file %s, got error: %s", data.ContentFile.ValueString(), err))
+		return
+	}
+	data.ContentHash = types.StringValue(firewallAliasContentHash(content))
+
+	// Entries of the content file are only known once it is read
+	if !data.Type.IsUnknown() {
+		resp.Diagnostics.Append(validateFirewallAliasContentFile(data, content)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Summarize content changes, since the diff of large aliases is unreadable
+	if state != nil && !state.ContentHash.Equal(data.ContentHash) {
+		prior, diags := r.getPriorContent(ctx, state)
+		resp.Diagnostics.Append(diags...)
+
+		if prior != nil {
+			added, removed := firewallAliasContentDiff(prior, content)
+			resp.Diagnostics.AddAttributeWarning(path.Root("content"), "Alias Content Changes",
+				fmt.Sprintf("The content of alias %s changes: %d entries added, %d entries removed.",
+					data.Name.ValueString(), added, removed))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+// validateFirewallAliasContentFile checks the entries read from the content
+// file against the type of the alias.
+func validateFirewallAliasContentFile(d *FirewallAliasResourceModel, content []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.ContentFile.IsNull() {
+		return diags
+	}
+
+	if invalid, description := validators.InvalidFirewallAliasContent(d.Type.ValueString(), content); len(invalid) > 0 {
+		diags.AddAttributeError(path.Root("content_file"), "Invalid Alias Content",
+			validators.FirewallAliasContentError(d.Type.ValueString(), description, invalid))
+	}
+
+	return diags
+}
+
 func (r *FirewallAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *FirewallAliasResourceModel
 
@@ -70,6 +169,15 @@ func (r *FirewallAliasResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Validate and hash content that was not known at plan time
+	if data.ContentHash.IsUnknown() {
+		resp.Diagnostics.Append(validateFirewallAliasContentFile(data, resourceStruct.Content)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ContentHash = types.StringValue(firewallAliasContentHash(resourceStruct.Content))
+	}
+
 	// Add firewall alias to unbound
 	id, err := r.client.Firewall().AddAlias(ctx, resourceStruct)
 	if err != nil {
@@ -132,15 +240,22 @@ func (r *FirewallAliasResource) Read(ctx context.Context, req resource.ReadReque
 	// ID cannot be added by convert... func, have to add here
 	resourceModel.Id = data.Id
 
+	// Content read from a file is only tracked by its hash
+	if !data.ContentFile.IsNull() {
+		resourceModel.ContentFile = data.ContentFile
+		resourceModel.Content = tools.EmptySetValue()
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
 }
 
 func (r *FirewallAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *FirewallAliasResourceModel
+	var data, state *FirewallAliasResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -154,8 +269,21 @@ func (r *FirewallAliasResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	// Update firewall alias in unbound
-	err = r.client.Firewall().UpdateAlias(ctx, data.Id.ValueString(), resourceStruct)
+	// Validate and hash content that was not known at plan time
+	if data.ContentHash.IsUnknown() {
+		resp.Diagnostics.Append(validateFirewallAliasContentFile(data, resourceStruct.Content)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ContentHash = types.StringValue(firewallAliasContentHash(resourceStruct.Content))
+	}
+
+	// Update firewall alias, leaving its content in place when it did not change
+	if data.ContentHash.Equal(state.ContentHash) {
+		err = r.client.Firewall().UpdateAliasSettings(ctx, data.Id.ValueString(), firewall.NewAliasSettings(resourceStruct))
+	} else {
+		err = r.client.Firewall().UpdateAlias(ctx, data.Id.ValueString(), resourceStruct)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("Unable to create firewall alias, got error: %s", err))
@@ -185,6 +313,32 @@ func (r *FirewallAliasResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+// getPriorContent returns the content of the alias before the planned change.
+// Content read from a file is not kept in state, so it is read from OPNsense.
+func (r *FirewallAliasResource) getPriorContent(ctx context.Context, state *FirewallAliasResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if state.ContentFile.IsNull() {
+		var content []string
+		diags.Append(state.Content.ElementsAs(ctx, &content, false)...)
+		return content, diags
+	}
+
+	// The provider is not configured yet, skip the summary
+	if r.client == nil {
+		return nil, diags
+	}
+
+	alias, err := r.client.Firewall().GetAlias(ctx, state.Id.ValueString())
+	if err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall alias, got error: %s", err))
+		return nil, diags
+	}
+
+	return alias.Content, diags
+}
+
 func (r *FirewallAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
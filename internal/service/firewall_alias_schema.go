@@ -2,11 +2,17 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
@@ -17,7 +23,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"os"
+	"sort"
+	"strings"
 	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
 )
 
 // FirewallAliasResourceModel describes the resource data model.
@@ -29,8 +39,32 @@ type FirewallAliasResourceModel struct {
 	IPProtocol types.String `tfsdk:"ip_protocol"`
 	Interface  types.String `tfsdk:"interface"`
 
-	Content    types.Set `tfsdk:"content"`
-	Categories types.Set `tfsdk:"categories"`
+	Content     types.Set    `tfsdk:"content"`
+	ContentFile types.String `tfsdk:"content_file"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Categories  types.Set    `tfsdk:"categories"`
+
+	UpdateFreq types.Float64 `tfsdk:"update_freq"`
+
+	Statistics  types.Bool   `tfsdk:"stats"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// FirewallAliasDataSourceModel describes the data source data model, which
+// lacks the content_file attribute of the resource.
+type FirewallAliasDataSourceModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+
+	IPProtocol types.String `tfsdk:"ip_protocol"`
+	Interface  types.String `tfsdk:"interface"`
+
+	Content     types.Set    `tfsdk:"content"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Categories  types.Set    `tfsdk:"categories"`
 
 	UpdateFreq types.Float64 `tfsdk:"update_freq"`
 
@@ -84,11 +118,23 @@ func FirewallAliasResourceSchema() schema.Schema {
 				Default:             stringdefault.StaticString(""),
 			},
 			"content": schema.SetAttribute{
-				MarkdownDescription: "The content of the alias. Enter ISO 3166-1 country codes when `type = \"geoip\"` (e.g. `[\"CA\", \"FR\"]`). Enter `__<int>_network`, or alias when `type = \"networkgroup\"` (e.g. `[\"__wan_network\", \"otheralias\"]`). Enter OpenVPN group when `type = \"authgroup\"` (e.g. `[\"admins\"]`). Set to `[]` when `type = \"external\"`. Defaults to `[]`.",
+				MarkdownDescription: "The content of the alias. Enter ISO 3166-1 country codes when `type = \"geoip\"` (e.g. `[\"CA\", \"FR\"]`). Enter `__<int>_network`, or alias when `type = \"networkgroup\"` (e.g. `[\"__wan_network\", \"otheralias\"]`). Enter OpenVPN group when `type = \"authgroup\"` (e.g. `[\"admins\"]`). Set to `[]` when `type = \"external\"`. Entries are validated against `type` (e.g. networks in CIDR notation when `type = \"network\"`). Conflicts with `content_file`. Defaults to `[]`.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
 				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					validators.IsFirewallAliasContent(),
+				},
+			},
+			"content_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a local file holding the content of the alias, one entry per line. Blank lines and lines starting with `#` are ignored. Only the hash of the content is kept in state (see `content_hash`), which suits aliases with thousands of entries. A file that does not exist yet, e.g. one generated by the same apply, is read at apply time. Conflicts with `content`.",
+				Optional:            true,
+			},
+			"content_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the content of the alias, used to detect changes to `content_file` and to the alias in OPNsense.",
+				Computed:            true,
 			},
 			"categories": schema.SetAttribute{
 				MarkdownDescription: "Set of category IDs to apply. Defaults to `[]`.",
@@ -158,6 +204,10 @@ func FirewallAliasDataSourceSchema() dschema.Schema {
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"content_hash": dschema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the content of the alias.",
+				Computed:            true,
+			},
 			"categories": dschema.SetAttribute{
 				MarkdownDescription: "Set of category IDs to apply.",
 				Computed:            true,
@@ -179,10 +229,149 @@ func FirewallAliasDataSourceSchema() dschema.Schema {
 	}
 }
 
+// readFirewallAliasContentFile returns the entries of an alias content file,
+// one per line, skipping blank lines, comments and duplicates.
+func readFirewallAliasContentFile(name string) ([]string, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var content []string
+	seen := map[string]bool{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		content = append(content, line)
+	}
+	return content, nil
+}
+
+// firewallAliasContent returns the configured content of the alias, read from
+// content_file if it is set.
+func firewallAliasContent(d *FirewallAliasResourceModel) ([]string, error) {
+	if !d.ContentFile.IsNull() {
+		return readFirewallAliasContentFile(d.ContentFile.ValueString())
+	}
+
+	var content []string
+	d.Content.ElementsAs(context.Background(), &content, false)
+	return content, nil
+}
+
+// firewallAliasContentHash returns the hash of the content of an alias. The
+// entries are sorted and deduplicated first, since OPNsense does not keep
+// their order. The empty entry OPNsense returns for empty aliases is skipped.
+func firewallAliasContentHash(content []string) string {
+	entries := map[string]bool{}
+	for _, entry := range content {
+		if entry != "" {
+			entries[entry] = true
+		}
+	}
+
+	var sorted []string
+	for entry := range entries {
+		sorted = append(sorted, entry)
+	}
+	sort.Strings(sorted)
+
+	hash := sha256.Sum256([]byte(strings.Join(sorted, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+// firewallAliasContentDiff counts the entries added to and removed from the
+// prior content of an alias.
+func firewallAliasContentDiff(prior []string, planned []string) (added int, removed int) {
+	priorEntries := map[string]bool{}
+	for _, entry := range prior {
+		if entry != "" {
+			priorEntries[entry] = true
+		}
+	}
+
+	plannedEntries := map[string]bool{}
+	for _, entry := range planned {
+		if entry == "" || plannedEntries[entry] {
+			continue
+		}
+		plannedEntries[entry] = true
+		if !priorEntries[entry] {
+			added++
+		}
+	}
+
+	for entry := range priorEntries {
+		if !plannedEntries[entry] {
+			removed++
+		}
+	}
+	return added, removed
+}
+
+func validateFirewallAliasConfig(d *FirewallAliasResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if !d.ContentFile.IsNull() && !d.Content.IsNull() && !d.Content.IsUnknown() && len(d.Content.Elements()) > 0 {
+		diagnostics.AddAttributeError(
+			path.Root("content_file"),
+			"Invalid Attribute Combination",
+			"Attributes content and content_file cannot be configured together.",
+		)
+	}
+
+	if d.Type.IsUnknown() {
+		return diagnostics
+	}
+
+	// update_freq only applies to urltable aliases
+	updateFreqSet := !d.UpdateFreq.IsNull() && d.UpdateFreq.ValueFloat64() != -1
+	if !d.UpdateFreq.IsUnknown() {
+		if d.Type.ValueString() == "urltable" && !updateFreqSet {
+			diagnostics.AddAttributeError(
+				path.Root("update_freq"),
+				"Missing Attribute Configuration",
+				"Attribute update_freq must be set when type is \"urltable\".",
+			)
+		} else if d.Type.ValueString() != "urltable" && updateFreqSet {
+			diagnostics.AddAttributeError(
+				path.Root("update_freq"),
+				"Invalid Attribute Combination",
+				"Attribute update_freq can only be set when type is \"urltable\".",
+			)
+		}
+	}
+
+	// interface only applies to dynipv6host aliases
+	interfaceSet := !d.Interface.IsNull() && d.Interface.ValueString() != ""
+	if !d.Interface.IsUnknown() {
+		if d.Type.ValueString() == "dynipv6host" && !interfaceSet {
+			diagnostics.AddAttributeError(
+				path.Root("interface"),
+				"Missing Attribute Configuration",
+				"Attribute interface must be set when type is \"dynipv6host\".",
+			)
+		} else if d.Type.ValueString() != "dynipv6host" && interfaceSet {
+			diagnostics.AddAttributeError(
+				path.Root("interface"),
+				"Invalid Attribute Combination",
+				"Attribute interface can only be set when type is \"dynipv6host\".",
+			)
+		}
+	}
+
+	return diagnostics
+}
+
 func convertFirewallAliasSchemaToStruct(d *FirewallAliasResourceModel) (*firewall.Alias, error) {
 	// Parse 'Content'
-	var contentList []string
-	d.Content.ElementsAs(context.Background(), &contentList, false)
+	contentList, err := firewallAliasContent(d)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read content file, got error: %w", err)
+	}
 
 	// Parse 'Categories'
 	var categoriesList []string
@@ -210,6 +399,7 @@ func convertFirewallAliasStructToSchema(d *firewall.Alias) (*FirewallAliasResour
 		IPProtocol:  types.StringValue(d.IPProtocol.String()),
 		Interface:   types.StringValue(d.Interface.String()),
 		Content:     types.SetNull(types.StringType),
+		ContentFile: types.StringNull(),
 		Categories:  types.SetNull(types.StringType),
 		UpdateFreq:  types.Float64Value(tools.StringToFloat64(d.UpdateFreq)),
 		Statistics:  types.BoolValue(tools.StringToBool(d.Statistics)),
@@ -227,6 +417,7 @@ func convertFirewallAliasStructToSchema(d *firewall.Alias) (*FirewallAliasResour
 	}
 	contentTypeList, _ := types.SetValue(types.StringType, contentList)
 	model.Content = contentTypeList
+	model.ContentHash = types.StringValue(firewallAliasContentHash(d.Content))
 
 	// Parse 'Categories'
 	var categoriesList []attr.Value
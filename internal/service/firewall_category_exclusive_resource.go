@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+func NewFirewallCategoryExclusiveResource() resource.Resource {
+	return &ExclusiveResource{kind: &exclusiveKind{
+		typeName: "firewall_category",
+		noun:     "category",
+		plural:   "categories",
+		list: func(ctx context.Context, c opnsense.Client) ([]exclusiveObject, error) {
+			rows, err := c.Firewall().SearchCategories(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var objects []exclusiveObject
+			for _, row := range rows {
+				objects = append(objects, exclusiveObject{Id: row.Id, Name: row.Name})
+			}
+			return objects, nil
+		},
+		delete: func(ctx context.Context, c opnsense.Client, id string) error {
+			return c.Firewall().DeleteCategory(ctx, id)
+		},
+	}}
+}
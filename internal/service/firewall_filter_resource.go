@@ -6,16 +6,18 @@ import (
 	"fmt"
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &FirewallFilterResource{}
 var _ resource.ResourceWithImportState = &FirewallFilterResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallFilterResource{}
+var _ resource.ResourceWithUpgradeState = &FirewallFilterResource{}
 
 func NewFirewallFilterResource() resource.Resource {
 	return &FirewallFilterResource{}
@@ -52,6 +54,61 @@ func (r *FirewallFilterResource) Configure(ctx context.Context, req resource.Con
 	r.client = opnsense.NewClient(apiClient)
 }
 
+func (r *FirewallFilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *FirewallFilterResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateFirewallFilterConfig(data)...)
+}
+
+func (r *FirewallFilterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchemaV0 := firewallFilterResourceSchemaV0()
+	priorSchemaV1 := firewallFilterResourceSchemaV1()
+
+	return map[int64]resource.StateUpgrader{
+		// Version 1 added the advanced options
+		0: {
+			PriorSchema: &priorSchemaV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorData *firewallFilterResourceModelV0
+
+				// Read prior Terraform state data into the model
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorData)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Save upgraded data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradeFirewallFilterStateV0(priorData))...)
+			},
+		},
+		// Version 2 allowed several networks per source and destination
+		1: {
+			PriorSchema: &priorSchemaV1,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorData *firewallFilterResourceModelV1
+
+				// Read prior Terraform state data into the model
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorData)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Save upgraded data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradeFirewallFilterStateV1(priorData))...)
+			},
+		},
+	}
+}
+
 func (r *FirewallFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *FirewallFilterResourceModel
 
@@ -88,6 +145,7 @@ func (r *FirewallFilterResource) Create(ctx context.Context, req resource.Create
 
 	// Tag new resource with ID from OPNsense
 	data.Id = types.StringValue(id)
+	data.Sequence = types.Int64Value(firewallFilterSequence(data))
 
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a resource")
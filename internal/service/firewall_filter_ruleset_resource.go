@@ -0,0 +1,411 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"reflect"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallFilterRulesetResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallFilterRulesetResource{}
+var _ resource.ResourceWithModifyPlan = &FirewallFilterRulesetResource{}
+
+func NewFirewallFilterRulesetResource() resource.Resource {
+	return &FirewallFilterRulesetResource{}
+}
+
+// FirewallFilterRulesetResource defines the resource implementation.
+type FirewallFilterRulesetResource struct {
+	client opnsense.Client
+}
+
+func (r *FirewallFilterRulesetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_filter_ruleset"
+}
+
+func (r *FirewallFilterRulesetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = firewallFilterRulesetResourceSchema()
+}
+
+func (r *FirewallFilterRulesetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *FirewallFilterRulesetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *FirewallFilterRulesetResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateFirewallFilterRulesetConfig(data)...)
+}
+
+func (r *FirewallFilterRulesetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state *FirewallFilterRulesetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The sequences are known as soon as the number of rules is
+	if data.SequenceStart.IsUnknown() || data.SequenceStep.IsUnknown() || firewallFilterRulesetCount(data) == -1 {
+		data.Sequences = types.ListUnknown(types.Int64Type)
+	} else {
+		sequences, diags := types.ListValueFrom(ctx, types.Int64Type, firewallFilterRulesetSequences(data))
+		resp.Diagnostics.Append(diags...)
+		data.Sequences = sequences
+	}
+
+	switch {
+	case data.Exclusive.IsUnknown():
+		data.UnmanagedRules = types.ListUnknown(types.StringType)
+	case data.Exclusive.ValueBool():
+		// Unmanaged rules are deleted on apply
+		data.UnmanagedRules = tools.EmptyListValue()
+	case state == nil || !data.Sequences.Equal(state.Sequences):
+		// Moving the rules changes which unmanaged rules are between them
+		data.UnmanagedRules = types.ListUnknown(types.StringType)
+	case len(state.UnmanagedRules.Elements()) > 0:
+		var unmanaged []string
+		state.UnmanagedRules.ElementsAs(ctx, &unmanaged, false)
+		resp.Diagnostics.AddAttributeWarning(path.Root("unmanaged_rules"), "Unmanaged Firewall Rules",
+			fmt.Sprintf("Found filter rules on interface %s that are not part of this ruleset between its rules: %s. "+
+				"Set exclusive to true to delete them.", data.Interface.ValueString(), strings.Join(unmanaged, ", ")))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+func (r *FirewallFilterRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallFilterRulesetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sequence the rules
+	resp.Diagnostics.Append(r.apply(ctx, data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tag new resource with the interface
+	data.Id = data.Interface
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallFilterRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallFilterRulesetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get all filter rules from OPNsense firewall API
+	filters, err := r.client.Firewall().ListFilters(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall filters, got error: %s", err))
+		return
+	}
+
+	var managed []string
+	var sequences []int64
+
+	if data.InlineRules != nil {
+		// Refresh inline rules, dropping the ones deleted outside of Terraform
+		var inlineRules []firewallFilterRulesetRule
+		for _, rule := range data.InlineRules {
+			filter, ok := filters[rule.Id.ValueString()]
+			if !ok {
+				tflog.Warn(ctx, fmt.Sprintf("inline filter rule %s not present in remote, removing from state", rule.Id.ValueString()))
+				continue
+			}
+
+			ruleModel, err := convertFirewallFilterRulesetRuleStructToSchema(&filter)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error",
+					fmt.Sprintf("Unable to read firewall filter, got error: %s", err))
+				return
+			}
+			ruleModel.Id = rule.Id
+
+			inlineRules = append(inlineRules, *ruleModel)
+			managed = append(managed, rule.Id.ValueString())
+			sequences = append(sequences, tools.StringToInt64(filter.Sequence))
+		}
+		data.InlineRules = inlineRules
+	} else {
+		// Rules that no longer exist have a sequence of -1
+		data.Rules.ElementsAs(ctx, &managed, false)
+		for _, id := range managed {
+			sequences = append(sequences, tools.StringToInt64(filters[id].Sequence))
+		}
+	}
+
+	sequenceList, diags := types.ListValueFrom(ctx, types.Int64Type, sequences)
+	resp.Diagnostics.Append(diags...)
+	data.Sequences = sequenceList
+
+	unmanaged := firewallFilterUnmanagedRules(filters, data.Interface.ValueString(), managed, sequences)
+	data.UnmanagedRules = tools.StringSliceToList(unmanaged)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallFilterRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *FirewallFilterRulesetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sequence the rules
+	resp.Diagnostics.Append(r.apply(ctx, data, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallFilterRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallFilterRulesetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Referenced rules are left in place, only inline rules are owned
+	for _, rule := range data.InlineRules {
+		err := r.client.Firewall().DeleteFilterStaged(ctx, rule.Id.ValueString())
+
+		var notFoundError *errs.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete firewall filter, got error: %s", err))
+			break
+		}
+	}
+
+	// Apply the deletions at once
+	if len(data.InlineRules) > 0 {
+		if err := r.client.Firewall().ApplyFilters(ctx); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to apply firewall filters, got error: %s", err))
+		}
+	}
+}
+
+// apply assigns the planned sequences to the rules of the ruleset, creating,
+// updating and deleting inline rules as needed, then deletes or records the
+// unmanaged rules found between them. The prior state is nil on create.
+func (r *FirewallFilterRulesetResource) apply(ctx context.Context, data *FirewallFilterRulesetResourceModel, state *FirewallFilterRulesetResourceModel) (diags diag.Diagnostics) {
+	// Rules are changed without applying the filter, then applied at once, so
+	// that they are never live with a mix of old and new sequences. Changes
+	// made before an error are applied too, so the running rules match the
+	// saved ones.
+	changed := false
+	defer func() {
+		if !changed {
+			return
+		}
+		if err := r.client.Firewall().ApplyFilters(ctx); err != nil {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to apply firewall filters, got error: %s", err))
+		}
+	}()
+
+	filters, err := r.client.Firewall().ListFilters(ctx)
+	if err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to read firewall filters, got error: %s", err))
+		return diags
+	}
+
+	iface := data.Interface.ValueString()
+	sequences := firewallFilterRulesetSequences(data)
+
+	var priorInlineRules []firewallFilterRulesetRule
+	if state != nil {
+		priorInlineRules = state.InlineRules
+	}
+
+	var managed []string
+
+	if data.InlineRules != nil {
+		for i := range data.InlineRules {
+			rule := &data.InlineRules[i]
+
+			filter, err := convertFirewallFilterRulesetRuleSchemaToStruct(rule, iface, sequences[i])
+			if err != nil {
+				diags.AddError("Client Error",
+					fmt.Sprintf("Unable to parse firewall filter, got error: %s", err))
+				return diags
+			}
+
+			// Rules are matched to the prior ones by position
+			if i < len(priorInlineRules) {
+				id := priorInlineRules[i].Id.ValueString()
+
+				if current, ok := filters[id]; ok {
+					prior, _ := convertFirewallFilterRulesetRuleSchemaToStruct(&priorInlineRules[i], iface, tools.StringToInt64(current.Sequence))
+					if !reflect.DeepEqual(prior, filter) {
+						changed = true
+						err = r.client.Firewall().UpdateFilterStaged(ctx, id, filter)
+						if err != nil {
+							diags.AddError("Client Error",
+								fmt.Sprintf("Unable to update firewall filter, got error: %s", err))
+							return diags
+						}
+					}
+
+					rule.Id = types.StringValue(id)
+					managed = append(managed, id)
+					continue
+				}
+			}
+
+			changed = true
+			id, err := r.client.Firewall().AddFilterStaged(ctx, filter)
+			if err != nil {
+				diags.AddError("Client Error",
+					fmt.Sprintf("Unable to create firewall filter, got error: %s", err))
+				return diags
+			}
+
+			rule.Id = types.StringValue(id)
+			managed = append(managed, id)
+		}
+	} else {
+		data.Rules.ElementsAs(ctx, &managed, false)
+
+		for i, id := range managed {
+			filter, ok := filters[id]
+			if !ok {
+				diags.AddAttributeError(path.Root("rules").AtListIndex(i), "Invalid Attribute Value",
+					fmt.Sprintf("Filter rule %s does not exist", id))
+				return diags
+			}
+			if !firewallFilterOnInterface(&filter, iface) {
+				diags.AddAttributeError(path.Root("rules").AtListIndex(i), "Invalid Attribute Value",
+					fmt.Sprintf("Filter rule %s does not apply to interface %s", id, iface))
+				return diags
+			}
+
+			if sequence := tools.Int64ToString(sequences[i]); filter.Sequence != sequence {
+				filter.Sequence = sequence
+				changed = true
+				err = r.client.Firewall().UpdateFilterStaged(ctx, id, &filter)
+				if err != nil {
+					diags.AddError("Client Error",
+						fmt.Sprintf("Unable to update firewall filter, got error: %s", err))
+					return diags
+				}
+			}
+		}
+	}
+
+	// Delete the prior inline rules that were removed from the ruleset
+	for i := len(data.InlineRules); i < len(priorInlineRules); i++ {
+		changed = true
+		err = r.client.Firewall().DeleteFilterStaged(ctx, priorInlineRules[i].Id.ValueString())
+
+		var notFoundError *errs.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to delete firewall filter, got error: %s", err))
+			return diags
+		}
+	}
+
+	unmanaged := firewallFilterUnmanagedRules(filters, iface, managed, sequences)
+
+	if data.Exclusive.ValueBool() {
+		for _, id := range unmanaged {
+			tflog.Info(ctx, fmt.Sprintf("deleting unmanaged filter rule %s from interface %s", id, iface))
+
+			changed = true
+			err = r.client.Firewall().DeleteFilterStaged(ctx, id)
+			if err != nil {
+				diags.AddError("Client Error",
+					fmt.Sprintf("Unable to delete unmanaged firewall filter, got error: %s", err))
+				return diags
+			}
+		}
+		unmanaged = []string{}
+	}
+
+	// Computed values not known at plan time
+	if data.Sequences.IsUnknown() {
+		sequenceList, d := types.ListValueFrom(ctx, types.Int64Type, sequences)
+		diags.Append(d...)
+		data.Sequences = sequenceList
+	}
+	if data.UnmanagedRules.IsUnknown() {
+		data.UnmanagedRules = tools.StringSliceToList(unmanaged)
+	}
+
+	return diags
+}
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strconv"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// firewallFilterMaxSequence is the highest sequence OPNsense accepts for a
+// filter rule.
+const firewallFilterMaxSequence = 99999
+
+// firewallFilterRulesetRule is a filter rule defined inline in a ruleset.
+type firewallFilterRulesetRule struct {
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Action     types.String `tfsdk:"action"`
+	Quick      types.Bool   `tfsdk:"quick"`
+	Direction  types.String `tfsdk:"direction"`
+	IPProtocol types.String `tfsdk:"ip_protocol"`
+	Protocol   types.String `tfsdk:"protocol"`
+
+	Source      *firewallFilterLocation `tfsdk:"source"`
+	Destination *firewallFilterLocation `tfsdk:"destination"`
+
+	Log         types.Bool   `tfsdk:"log"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// FirewallFilterRulesetResourceModel describes the resource data model.
+type FirewallFilterRulesetResourceModel struct {
+	Interface   types.String                `tfsdk:"interface"`
+	Rules       types.List                  `tfsdk:"rules"`
+	InlineRules []firewallFilterRulesetRule `tfsdk:"inline_rules"`
+
+	SequenceStart types.Int64 `tfsdk:"sequence_start"`
+	SequenceStep  types.Int64 `tfsdk:"sequence_step"`
+	Exclusive     types.Bool  `tfsdk:"exclusive"`
+
+	Sequences      types.List `tfsdk:"sequences"`
+	UnmanagedRules types.List `tfsdk:"unmanaged_rules"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// firewallFilterRulesetLocationAttribute returns the source or destination
+// attribute of an inline rule, which mirrors the one of opnsense_firewall_filter.
+func firewallFilterRulesetLocationAttribute(target string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s of the packets matched by this rule. Defaults to any network and port.", target),
+		Optional:            true,
+		Computed:            true,
+		Default: objectdefault.StaticValue(
+			types.ObjectValueMust(
+				map[string]attr.Type{
+					"net":    types.SetType{ElemType: types.StringType},
+					"port":   types.Int64Type,
+					"invert": types.BoolType,
+				},
+				map[string]attr.Value{
+					"net":    firewallFilterAnyNet(),
+					"port":   types.Int64Value(-1),
+					"invert": types.BoolValue(false),
+				},
+			),
+		),
+		Attributes: map[string]schema.Attribute{
+			"net": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("Specify the IP addresses, CIDRs or aliases for the %s of the packet for this rule. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Defaults to `[\"any\"]`.", target),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(firewallFilterAnyNet()),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Specify the %s port for this rule. Set to `-1` to match any port. Defaults to `-1`.", target),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"invert": schema.BoolAttribute{
+				MarkdownDescription: "Use this option to invert the sense of the match. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func firewallFilterRulesetResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Firewall filter rulesets own the order of the filter rules of an interface. Rules are either referenced by UUID (see `opnsense_firewall_filter`) or defined inline, and are assigned sequence numbers in the order they are listed, leaving gaps for rules added in between. Unmanaged rules found between them are reported, or removed when `exclusive` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface or interface group (e.g. `lan`) the rules apply to. Referenced rules must be on this interface, and inline rules are created on it.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListAttribute{
+				MarkdownDescription: "Ordered list of the UUIDs of the filter rules (see `opnsense_firewall_filter`) to sequence. The `sequence` of these rules is managed by this resource, so it must not be set on them. Exactly one of `rules` and `inline_rules` must be set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"inline_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of filter rules created and owned by this resource. Exactly one of `rules` and `inline_rules` must be set.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Enable this firewall filter rule. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Choose what to do with packets that match the criteria of this rule. Available values: `pass`, `block`, `reject`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("pass", "block", "reject"),
+							},
+						},
+						"quick": schema.BoolAttribute{
+							MarkdownDescription: "If a packet matches a rule specifying quick, then that rule is considered the last matching rule and the specified action is taken. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Direction of the traffic. Available values: `in`, `out`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("in", "out"),
+							},
+						},
+						"ip_protocol": schema.StringAttribute{
+							MarkdownDescription: "Select the Internet Protocol version this rule applies to. Available values: `inet`, `inet6`, `inet46`. Defaults to `inet`.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("inet", "inet6", "inet46"),
+							},
+							Default: stringdefault.StaticString("inet"),
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Choose which IP protocol this rule should match.",
+							Required:            true,
+						},
+						"source":      firewallFilterRulesetLocationAttribute("source"),
+						"destination": firewallFilterRulesetLocationAttribute("destination"),
+						"log": schema.BoolAttribute{
+							MarkdownDescription: "Log packets that are handled by this rule. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Optional description here for your reference (not parsed).",
+							Optional:            true,
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "UUID of the filter rule.",
+						},
+					},
+				},
+			},
+			"sequence_start": schema.Int64Attribute{
+				MarkdownDescription: "Sequence assigned to the first rule. Defaults to `100`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(100),
+				Validators: []validator.Int64{
+					int64validator.Between(1, firewallFilterMaxSequence),
+				},
+			},
+			"sequence_step": schema.Int64Attribute{
+				MarkdownDescription: "Gap between the sequences of consecutive rules, which leaves room for rules added in between. Defaults to `100`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(100),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"exclusive": schema.BoolAttribute{
+				MarkdownDescription: "Delete the unmanaged rules of the interface found between the rules of this ruleset. When `false`, they are only reported in `unmanaged_rules`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sequences": schema.ListAttribute{
+				MarkdownDescription: "Sequences of the rules, in the order they are listed.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"unmanaged_rules": schema.ListAttribute{
+				MarkdownDescription: "UUIDs of the unmanaged rules of the interface found between the rules of this ruleset, ordered by sequence.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Interface of the ruleset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// firewallFilterRulesetCount returns the number of rules of the ruleset, or
+// -1 if it is not known yet.
+func firewallFilterRulesetCount(d *FirewallFilterRulesetResourceModel) int {
+	if d.InlineRules != nil {
+		return len(d.InlineRules)
+	}
+	if d.Rules.IsUnknown() {
+		return -1
+	}
+	return len(d.Rules.Elements())
+}
+
+// firewallFilterRulesetSequences returns the sequences assigned to the rules
+// of the ruleset, in the order they are listed.
+func firewallFilterRulesetSequences(d *FirewallFilterRulesetResourceModel) []int64 {
+	count := firewallFilterRulesetCount(d)
+
+	var sequences []int64
+	for i := 0; i < count; i++ {
+		sequences = append(sequences, d.SequenceStart.ValueInt64()+int64(i)*d.SequenceStep.ValueInt64())
+	}
+	return sequences
+}
+
+func validateFirewallFilterRulesetConfig(d *FirewallFilterRulesetResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if !d.Rules.IsUnknown() && d.Rules.IsNull() == (d.InlineRules == nil) {
+		diagnostics.AddAttributeError(
+			path.Root("rules"),
+			"Invalid Attribute Combination",
+			"Exactly one of rules and inline_rules must be configured.",
+		)
+		return diagnostics
+	}
+
+	// The last rule must still fit in the sequence range
+	if d.SequenceStart.IsUnknown() || d.SequenceStep.IsUnknown() {
+		return diagnostics
+	}
+	if sequences := firewallFilterRulesetSequences(d); len(sequences) > 0 && sequences[len(sequences)-1] > firewallFilterMaxSequence {
+		diagnostics.AddAttributeError(
+			path.Root("sequence_step"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The sequence of the last rule must be at most %d, got: %d", firewallFilterMaxSequence, sequences[len(sequences)-1]),
+		)
+	}
+
+	return diagnostics
+}
+
+func convertFirewallFilterRulesetRuleSchemaToStruct(d *firewallFilterRulesetRule, iface string, sequence int64) (*firewall.Filter, error) {
+	return &firewall.Filter{
+		Enabled:           tools.BoolToString(d.Enabled.ValueBool()),
+		Sequence:          tools.Int64ToString(sequence),
+		Action:            api.SelectedMap(d.Action.ValueString()),
+		Quick:             tools.BoolToString(d.Quick.ValueBool()),
+		Interface:         []string{iface},
+		Direction:         api.SelectedMap(d.Direction.ValueString()),
+		IPProtocol:        api.SelectedMap(d.IPProtocol.ValueString()),
+		Protocol:          api.SelectedMap(d.Protocol.ValueString()),
+		SourceNet:         firewallFilterNetToString(d.Source.Net),
+		SourcePort:        tools.Int64ToStringNegative(d.Source.Port.ValueInt64()),
+		SourceInvert:      tools.BoolToString(d.Source.Invert.ValueBool()),
+		DestinationNet:    firewallFilterNetToString(d.Destination.Net),
+		DestinationPort:   tools.Int64ToStringNegative(d.Destination.Port.ValueInt64()),
+		DestinationInvert: tools.BoolToString(d.Destination.Invert.ValueBool()),
+		Log:               tools.BoolToString(d.Log.ValueBool()),
+		Description:       d.Description.ValueString(),
+
+		// Inline rules use the defaults of the advanced options
+		StateType:    api.SelectedMap("keep"),
+		AllowOptions: tools.BoolToString(false),
+		NoSync:       tools.BoolToString(false),
+	}, nil
+}
+
+func convertFirewallFilterRulesetRuleStructToSchema(d *firewall.Filter) (*firewallFilterRulesetRule, error) {
+	return &firewallFilterRulesetRule{
+		Enabled:    types.BoolValue(tools.StringToBool(d.Enabled)),
+		Action:     types.StringValue(d.Action.String()),
+		Quick:      types.BoolValue(tools.StringToBool(d.Quick)),
+		Direction:  types.StringValue(d.Direction.String()),
+		IPProtocol: types.StringValue(d.IPProtocol.String()),
+		Protocol:   types.StringValue(d.Protocol.String()),
+		Source: &firewallFilterLocation{
+			Net:    firewallFilterStringToNet(d.SourceNet),
+			Port:   types.Int64Value(tools.StringToInt64(d.SourcePort)),
+			Invert: types.BoolValue(tools.StringToBool(d.SourceInvert)),
+		},
+		Destination: &firewallFilterLocation{
+			Net:    firewallFilterStringToNet(d.DestinationNet),
+			Port:   types.Int64Value(tools.StringToInt64(d.DestinationPort)),
+			Invert: types.BoolValue(tools.StringToBool(d.DestinationInvert)),
+		},
+		Log:         types.BoolValue(tools.StringToBool(d.Log)),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
+
+// firewallFilterOnInterface reports whether the filter rule applies to the
+// interface.
+func firewallFilterOnInterface(d *firewall.Filter, iface string) bool {
+	for _, i := range d.Interface {
+		if i == iface {
+			return true
+		}
+	}
+	return false
+}
+
+// firewallFilterUnmanagedRules returns the UUIDs of the rules of the interface
+// that are not part of managed, but have a sequence between the lowest and
+// highest of the sequences given (ignoring the -1 of missing rules). They are
+// ordered by sequence.
+func firewallFilterUnmanagedRules(filters map[string]firewall.Filter, iface string, managed []string, sequences []int64) []string {
+	low, high := int64(-1), int64(-1)
+	for _, s := range sequences {
+		if s < 0 {
+			continue
+		}
+		if low == -1 || s < low {
+			low = s
+		}
+		if s > high {
+			high = s
+		}
+	}
+	if low == -1 {
+		return []string{}
+	}
+
+	isManaged := map[string]bool{}
+	for _, id := range managed {
+		isManaged[id] = true
+	}
+
+	unmanaged := []string{}
+	for id, filter := range filters {
+		filter := filter
+		if isManaged[id] || !firewallFilterOnInterface(&filter, iface) {
+			continue
+		}
+		if sequence, err := strconv.ParseInt(filter.Sequence, 10, 64); err == nil && sequence >= low && sequence <= high {
+			unmanaged = append(unmanaged, id)
+		}
+	}
+
+	sort.Slice(unmanaged, func(i, j int) bool {
+		a := tools.StringToInt64(filters[unmanaged[i]].Sequence)
+		b := tools.StringToInt64(filters[unmanaged[j]].Sequence)
+		if a != b {
+			return a < b
+		}
+		return unmanaged[i] < unmanaged[j]
+	})
+
+	return unmanaged
+}
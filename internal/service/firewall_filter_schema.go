@@ -2,31 +2,61 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/firewall"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
 	"terraform-provider-opnsense/internal/tools"
 )
 
+var firewallFilterTCPFlags = []string{"syn", "ack", "fin", "rst", "psh", "urg", "ece", "cwr"}
+
+var firewallFilterICMPTypes = []string{
+	"echoreq", "echorep", "unreach", "squench", "redir", "althost", "routeradv", "routersol", "timex",
+	"paramprob", "timereq", "timerep", "inforeq", "inforep", "maskreq", "maskrep",
+}
+
+var firewallFilterICMPv6Types = []string{
+	"unreach", "toobig", "timex", "paramprob", "echoreq", "echorep", "groupqry", "grouprep", "groupterm",
+	"routersol", "routeradv", "neighbrsol", "neighbradv", "redir", "routrrenum", "fqdnreq", "fqdnrep",
+	"niqry", "nirep", "mtraceresp", "mtrace",
+}
+
 type firewallLocation struct {
 	Net    types.String `tfsdk:"net"`
 	Port   types.Int64  `tfsdk:"port"`
 	Invert types.Bool   `tfsdk:"invert"`
 }
 
+// firewallFilterLocation is the source or destination of a filter rule,
+// which unlike other rules can match several networks.
+type firewallFilterLocation struct {
+	Net    types.Set   `tfsdk:"net"`
+	Port   types.Int64 `tfsdk:"port"`
+	Invert types.Bool  `tfsdk:"invert"`
+}
+
 // FirewallFilterResourceModel describes the resource data model.
 type FirewallFilterResourceModel struct {
 	Enabled  types.Bool   `tfsdk:"enabled"`
@@ -40,19 +70,46 @@ type FirewallFilterResourceModel struct {
 	IPProtocol types.String `tfsdk:"ip_protocol"`
 	Protocol   types.String `tfsdk:"protocol"`
 
-	Source      *firewallLocation `tfsdk:"source"`
-	Destination *firewallLocation `tfsdk:"destination"`
+	Source      *firewallFilterLocation `tfsdk:"source"`
+	Destination *firewallFilterLocation `tfsdk:"destination"`
 
-	Gateway types.String `tfsdk:"gateway"`
-	Log     types.Bool   `tfsdk:"log"`
+	Gateway  types.String `tfsdk:"gateway"`
+	Schedule types.String `tfsdk:"schedule"`
+	Log      types.Bool   `tfsdk:"log"`
 
 	Description types.String `tfsdk:"description"`
 
+	StateType                     types.String `tfsdk:"state_type"`
+	StateTimeout                  types.Int64  `tfsdk:"state_timeout"`
+	MaxStates                     types.Int64  `tfsdk:"max_states"`
+	MaxSourceNodes                types.Int64  `tfsdk:"max_source_nodes"`
+	MaxSourceStates               types.Int64  `tfsdk:"max_source_states"`
+	MaxSourceConnections          types.Int64  `tfsdk:"max_source_connections"`
+	MaxSourceConnectionRate       types.Int64  `tfsdk:"max_source_connection_rate"`
+	MaxSourceConnectionRateWindow types.Int64  `tfsdk:"max_source_connection_rate_window"`
+	TCPFlags                      types.Set    `tfsdk:"tcp_flags"`
+	TCPFlagsOutOf                 types.Set    `tfsdk:"tcp_flags_out_of"`
+	ICMPTypes                     types.Set    `tfsdk:"icmp_types"`
+	ICMPv6Types                   types.Set    `tfsdk:"icmpv6_types"`
+	Tag                           types.String `tfsdk:"tag"`
+	Tagged                        types.String `tfsdk:"tagged"`
+	AllowOptions                  types.Bool   `tfsdk:"allow_options"`
+	NoSync                        types.Bool   `tfsdk:"no_sync"`
+	Categories                    types.Set    `tfsdk:"categories"`
+	ReplyTo                       types.String `tfsdk:"reply_to"`
+
 	Id types.String `tfsdk:"id"`
 }
 
+// firewallFilterLimitValidator allows either a positive limit, or -1 for no limit.
+func firewallFilterLimitValidator() validator.Int64 {
+	return int64validator.Any(int64validator.OneOf(-1), int64validator.AtLeast(1))
+}
+
 func FirewallFilterResourceSchema() schema.Schema {
 	return schema.Schema{
+		Version: 2,
+
 		MarkdownDescription: "Firewall filter rules can be used to restrict or allow traffic from and/or to specific networks as well as influence how traffic should be forwarded",
 
 		Attributes: map[string]schema.Attribute{
@@ -63,10 +120,12 @@ func FirewallFilterResourceSchema() schema.Schema {
 				Default:             booldefault.StaticBool(true),
 			},
 			"sequence": schema.Int64Attribute{
-				MarkdownDescription: "Specify the order of this filter rule. Defaults to `1`.",
+				MarkdownDescription: "Specify the order of this filter rule. When not set, the rule is created with sequence `1`, and any sequence assigned to it later (e.g. by `opnsense_firewall_filter_ruleset`) is kept. Leave unset on rules ordered by a ruleset.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"action": schema.StringAttribute{
 				MarkdownDescription: "Choose what to do with packets that match the criteria specified below. Hint: the difference between block and reject is that with reject, a packet (TCP RST or ICMP port unreachable for UDP) is returned to the sender, whereas with block the packet is dropped silently. In either case, the original packet is discarded. Available values: `pass`, `block`, `reject`.",
@@ -97,11 +156,11 @@ func FirewallFilterResourceSchema() schema.Schema {
 				},
 			},
 			"ip_protocol": schema.StringAttribute{
-				MarkdownDescription: "Select the Internet Protocol version this rule applies to. Available values: `inet`, `inet6`. Defaults to `inet`.",
+				MarkdownDescription: "Select the Internet Protocol version this rule applies to. Use `inet46` to match both IPv4 and IPv6. Available values: `inet`, `inet6`, `inet46`. Defaults to `inet`.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("inet", "inet6"),
+					stringvalidator.OneOf("inet", "inet6", "inet46"),
 				},
 				Default: stringdefault.StaticString("inet"),
 			},
@@ -115,23 +174,27 @@ func FirewallFilterResourceSchema() schema.Schema {
 				Default: objectdefault.StaticValue(
 					types.ObjectValueMust(
 						map[string]attr.Type{
-							"net":    types.StringType,
+							"net":    types.SetType{ElemType: types.StringType},
 							"port":   types.Int64Type,
 							"invert": types.BoolType,
 						},
 						map[string]attr.Value{
-							"net":    types.StringValue("any"),
+							"net":    firewallFilterAnyNet(),
 							"port":   types.Int64Value(-1),
 							"invert": types.BoolValue(false),
 						},
 					),
 				),
 				Attributes: map[string]schema.Attribute{
-					"net": schema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the source of the packet for this mapping. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Defaults to `any`.",
+					"net": schema.SetAttribute{
+						MarkdownDescription: "Specify the IP addresses, CIDRs or aliases for the source of the packet for this rule. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Several networks can only be combined on OPNsense releases that support it. Defaults to `[\"any\"]`.",
 						Optional:            true,
 						Computed:            true,
-						Default:             stringdefault.StaticString("any"),
+						ElementType:         types.StringType,
+						Default:             setdefault.StaticValue(firewallFilterAnyNet()),
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
 					},
 					"port": schema.Int64Attribute{
 						MarkdownDescription: "Specify the source port for this rule. This is usually random and almost never equal to the destination port range (and should usually be `-1`). Defaults to `-1`.",
@@ -153,23 +216,27 @@ func FirewallFilterResourceSchema() schema.Schema {
 				Default: objectdefault.StaticValue(
 					types.ObjectValueMust(
 						map[string]attr.Type{
-							"net":    types.StringType,
+							"net":    types.SetType{ElemType: types.StringType},
 							"port":   types.Int64Type,
 							"invert": types.BoolType,
 						},
 						map[string]attr.Value{
-							"net":    types.StringValue("any"),
+							"net":    firewallFilterAnyNet(),
 							"port":   types.Int64Value(-1),
 							"invert": types.BoolValue(false),
 						},
 					),
 				),
 				Attributes: map[string]schema.Attribute{
-					"net": schema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the destination of the packet for this mapping. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Defaults to `any`.",
+					"net": schema.SetAttribute{
+						MarkdownDescription: "Specify the IP addresses, CIDRs or aliases for the destination of the packet for this rule. For `<INT> net`, enter `<int>` (e.g. `lan`). For `<INT> address`, enter `<int>ip` (e.g. `lanip`). Several networks can only be combined on OPNsense releases that support it. Defaults to `[\"any\"]`.",
 						Optional:            true,
 						Computed:            true,
-						Default:             stringdefault.StaticString("any"),
+						ElementType:         types.StringType,
+						Default:             setdefault.StaticValue(firewallFilterAnyNet()),
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
 					},
 					"port": schema.Int64Attribute{
 						MarkdownDescription: "Destination port number or well known name (imap, imaps, http, https, ...), for ranges use a dash. Defaults to `-1`.",
@@ -191,8 +258,18 @@ func FirewallFilterResourceSchema() schema.Schema {
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Name of the schedule during which this rule is active, as configured in Firewall > Settings > Schedules. Schedules must be created in the web UI, since OPNsense has no API to manage them, so this provider has no schedule resource and cannot check that the schedule exists. Leave as `\"\"` to keep the rule active at all times. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(firewallFilterScheduleRegex,
+						"must be a schedule name of at most 32 letters, digits, `_` or `-`"),
+				},
+			},
 			"log": schema.BoolAttribute{
-				MarkdownDescription: "Log packets that are handled by this rule. Defaults to `false`.",
+				MarkdownDescription: "Log packets that are handled by this rule. Logged packets can be forwarded to a remote server with `opnsense_syslog_destination` (program `filterlog`). Defaults to `false`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -201,6 +278,155 @@ func FirewallFilterResourceSchema() schema.Schema {
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Optional:            true,
 			},
+			"state_type": schema.StringAttribute{
+				MarkdownDescription: "State tracking mechanism. `sloppy` does not check sequence numbers, which is needed for asymmetric routing. `synproxy` proxies incoming TCP connections to protect servers from spoofed SYN floods. `none` does not track state at all. Available values: `keep`, `sloppy`, `synproxy`, `none`. Defaults to `keep`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("keep"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("keep", "sloppy", "synproxy", "none"),
+				},
+			},
+			"state_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout, in seconds, of idle states created by this rule. Set to `-1` to use the global timeouts. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					firewallFilterLimitValidator(),
+				},
+			},
+			"max_states": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of states this rule can create. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					firewallFilterLimitValidator(),
+				},
+			},
+			"max_source_nodes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of source hosts that can create states with this rule. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					firewallFilterLimitValidator(),
+				},
+			},
+			"max_source_states": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of states a single source host can create with this rule. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					firewallFilterLimitValidator(),
+				},
+			},
+			"max_source_connections": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of established TCP connections a single source host can have with this rule. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					firewallFilterLimitValidator(),
+				},
+			},
+			"max_source_connection_rate": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of new TCP connections a single source host can open per `max_source_connection_rate_window` seconds. Hosts exceeding the rate are added to the `virusprot` table. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					firewallFilterLimitValidator(),
+				},
+			},
+			"max_source_connection_rate_window": schema.Int64Attribute{
+				MarkdownDescription: "Window, in seconds, of `max_source_connection_rate`. Must be set together with `max_source_connection_rate`. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+				Validators: []validator.Int64{
+					firewallFilterLimitValidator(),
+				},
+			},
+			"tcp_flags": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("TCP flags that must be set for this rule to match. Only applies when `protocol = \"TCP\"`. Available values: `%s`. Defaults to `[]`.", strings.Join(firewallFilterTCPFlags, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(firewallFilterTCPFlags...)),
+				},
+			},
+			"tcp_flags_out_of": schema.SetAttribute{
+				MarkdownDescription: "TCP flags that are checked, the flags in `tcp_flags` must be set and the others cleared. Must include all of `tcp_flags`. Only applies when `protocol = \"TCP\"`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(firewallFilterTCPFlags...)),
+				},
+			},
+			"icmp_types": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("ICMP types to match. Matches all types when empty. Only applies when `protocol = \"ICMP\"`. Available values: `%s`. Defaults to `[]`.", strings.Join(firewallFilterICMPTypes, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(firewallFilterICMPTypes...)),
+				},
+			},
+			"icmpv6_types": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("ICMPv6 types to match. Matches all types when empty. Only applies when `protocol = \"IPV6-ICMP\"`. Available values: `%s`. Defaults to `[]`.", strings.Join(firewallFilterICMPv6Types, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(firewallFilterICMPv6Types...)),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Tag packets matched by this rule, so that they can be matched by `tagged` in other rules (or NAT rules).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"tagged": schema.StringAttribute{
+				MarkdownDescription: "Only match packets previously tagged with this tag by another rule.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"allow_options": schema.BoolAttribute{
+				MarkdownDescription: "Allow packets with IP options to pass. By default, they are blocked. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"no_sync": schema.BoolAttribute{
+				MarkdownDescription: "Do not sync the states created by this rule to other cluster members with pfsync. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"categories": schema.SetAttribute{
+				MarkdownDescription: "Set of category IDs (see `opnsense_firewall_category`) to apply. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"reply_to": schema.StringAttribute{
+				MarkdownDescription: "Gateway to send replies to the traffic matched by this rule to, overriding the default reply-to of the interface gateway. Use the system routing table when not set.",
+				Optional:            true,
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "UUID of the resource.",
@@ -247,7 +473,7 @@ func FirewallFilterDataSourceSchema() dschema.Schema {
 				Computed:            true,
 			},
 			"ip_protocol": dschema.StringAttribute{
-				MarkdownDescription: "Select the Internet Protocol version this rule applies to. Available values: `inet`, `inet6`.",
+				MarkdownDescription: "Select the Internet Protocol version this rule applies to. Available values: `inet`, `inet6`, `inet46`.",
 				Computed:            true,
 			},
 			"protocol": dschema.StringAttribute{
@@ -257,8 +483,9 @@ func FirewallFilterDataSourceSchema() dschema.Schema {
 			"source": dschema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]dschema.Attribute{
-					"net": dschema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the source of the packet for this mapping.",
+					"net": dschema.SetAttribute{
+						MarkdownDescription: "The IP addresses, CIDRs or aliases for the source of the packet for this rule.",
+						ElementType:         types.StringType,
 						Computed:            true,
 					},
 					"port": dschema.Int64Attribute{
@@ -274,8 +501,9 @@ func FirewallFilterDataSourceSchema() dschema.Schema {
 			"destination": dschema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]dschema.Attribute{
-					"net": dschema.StringAttribute{
-						MarkdownDescription: "Specify the IP address, CIDR or alias for the destination of the packet for this mapping.",
+					"net": dschema.SetAttribute{
+						MarkdownDescription: "The IP addresses, CIDRs or aliases for the destination of the packet for this rule.",
+						ElementType:         types.StringType,
 						Computed:            true,
 					},
 					"port": dschema.Int64Attribute{
@@ -292,6 +520,10 @@ func FirewallFilterDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Leave as `\"\"` to use the system routing table. Or choose a gateway to utilize policy based routing.",
 				Computed:            true,
 			},
+			"schedule": dschema.StringAttribute{
+				MarkdownDescription: "Name of the schedule during which this rule is active. `\"\"` if the rule is active at all times.",
+				Computed:            true,
+			},
 			"log": dschema.BoolAttribute{
 				MarkdownDescription: "Log packets that are handled by this rule.",
 				Computed:            true,
@@ -300,33 +532,276 @@ func FirewallFilterDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Computed:            true,
 			},
+			"state_type": dschema.StringAttribute{
+				MarkdownDescription: "State tracking mechanism.",
+				Computed:            true,
+			},
+			"state_timeout": dschema.Int64Attribute{
+				MarkdownDescription: "Timeout, in seconds, of idle states created by this rule. `-1` if the global timeouts are used.",
+				Computed:            true,
+			},
+			"max_states": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of states this rule can create. `-1` if there is no limit.",
+				Computed:            true,
+			},
+			"max_source_nodes": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of source hosts that can create states with this rule. `-1` if there is no limit.",
+				Computed:            true,
+			},
+			"max_source_states": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of states a single source host can create with this rule. `-1` if there is no limit.",
+				Computed:            true,
+			},
+			"max_source_connections": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of established TCP connections a single source host can have with this rule. `-1` if there is no limit.",
+				Computed:            true,
+			},
+			"max_source_connection_rate": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of new TCP connections a single source host can open per `max_source_connection_rate_window` seconds. `-1` if there is no limit.",
+				Computed:            true,
+			},
+			"max_source_connection_rate_window": dschema.Int64Attribute{
+				MarkdownDescription: "Window, in seconds, of `max_source_connection_rate`. `-1` if there is no limit.",
+				Computed:            true,
+			},
+			"tcp_flags": dschema.SetAttribute{
+				MarkdownDescription: "TCP flags that must be set for this rule to match.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tcp_flags_out_of": dschema.SetAttribute{
+				MarkdownDescription: "TCP flags that are checked.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"icmp_types": dschema.SetAttribute{
+				MarkdownDescription: "ICMP types matched by this rule.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"icmpv6_types": dschema.SetAttribute{
+				MarkdownDescription: "ICMPv6 types matched by this rule.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tag": dschema.StringAttribute{
+				MarkdownDescription: "Tag applied to packets matched by this rule.",
+				Computed:            true,
+			},
+			"tagged": dschema.StringAttribute{
+				MarkdownDescription: "Tag packets must have been tagged with to match this rule.",
+				Computed:            true,
+			},
+			"allow_options": dschema.BoolAttribute{
+				MarkdownDescription: "Whether packets with IP options are allowed to pass.",
+				Computed:            true,
+			},
+			"no_sync": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the states created by this rule are not synced with pfsync.",
+				Computed:            true,
+			},
+			"categories": dschema.SetAttribute{
+				MarkdownDescription: "Set of category IDs applied.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"reply_to": dschema.StringAttribute{
+				MarkdownDescription: "Gateway replies to the traffic matched by this rule are sent to.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
+// firewallFilterAnyNet returns the set matching any network.
+func firewallFilterAnyNet() types.Set {
+	return tools.StringSliceToSet([]string{"any"})
+}
+
+// firewallFilterNetToString joins the networks of a source or destination
+// into the comma separated list used by OPNsense, sorted so that the API
+// representation is stable.
+func firewallFilterNetToString(s types.Set) string {
+	var netList []string
+	s.ElementsAs(context.Background(), &netList, false)
+	sort.Strings(netList)
+	return strings.Join(netList, ",")
+}
+
+// firewallFilterStringToNet splits the comma separated list of networks
+// returned by OPNsense into a set, ignoring whitespace and empty entries.
+func firewallFilterStringToNet(s string) types.Set {
+	var netList []string
+	for _, net := range strings.Split(s, ",") {
+		if net = strings.TrimSpace(net); net != "" {
+			netList = append(netList, net)
+		}
+	}
+	if len(netList) == 0 {
+		return firewallFilterAnyNet()
+	}
+	return tools.StringSliceToSet(netList)
+}
+
+func validateFirewallFilterConfig(d *FirewallFilterResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	protocol := strings.ToUpper(d.Protocol.ValueString())
+	protocolKnown := !d.Protocol.IsUnknown()
+
+	// TCP flags
+	if protocolKnown && protocol != "TCP" {
+		for name, value := range map[string]types.Set{"tcp_flags": d.TCPFlags, "tcp_flags_out_of": d.TCPFlagsOutOf} {
+			if len(value.Elements()) > 0 {
+				diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("Attribute %s can only be configured when protocol is TCP.", name),
+				)
+			}
+		}
+	}
+	if !d.TCPFlags.IsUnknown() && !d.TCPFlagsOutOf.IsUnknown() && len(d.TCPFlags.Elements()) > 0 {
+		outOf := map[string]bool{}
+		for _, flag := range d.TCPFlagsOutOf.Elements() {
+			outOf[flag.(types.String).ValueString()] = true
+		}
+		for _, flag := range d.TCPFlags.Elements() {
+			if !outOf[flag.(types.String).ValueString()] {
+				diagnostics.AddAttributeError(
+					path.Root("tcp_flags_out_of"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Attribute tcp_flags_out_of must include all of tcp_flags, missing: %s", flag.(types.String).ValueString()),
+				)
+			}
+		}
+	}
+
+	// ICMP types
+	if protocolKnown && protocol != "ICMP" && len(d.ICMPTypes.Elements()) > 0 {
+		diagnostics.AddAttributeError(
+			path.Root("icmp_types"),
+			"Invalid Attribute Combination",
+			"Attribute icmp_types can only be configured when protocol is ICMP.",
+		)
+	}
+	if protocolKnown && protocol != "IPV6-ICMP" && len(d.ICMPv6Types.Elements()) > 0 {
+		diagnostics.AddAttributeError(
+			path.Root("icmpv6_types"),
+			"Invalid Attribute Combination",
+			"Attribute icmpv6_types can only be configured when protocol is IPV6-ICMP.",
+		)
+	}
+
+	// State tracking
+	if d.StateType.ValueString() == "synproxy" && protocolKnown && protocol != "TCP" {
+		diagnostics.AddAttributeError(
+			path.Root("state_type"),
+			"Invalid Attribute Combination",
+			"Attribute state_type can only be synproxy when protocol is TCP.",
+		)
+	}
+	if d.StateType.ValueString() == "none" {
+		limits := map[string]types.Int64{
+			"state_timeout":              d.StateTimeout,
+			"max_states":                 d.MaxStates,
+			"max_source_nodes":           d.MaxSourceNodes,
+			"max_source_states":          d.MaxSourceStates,
+			"max_source_connections":     d.MaxSourceConnections,
+			"max_source_connection_rate": d.MaxSourceConnectionRate,
+		}
+		for name, value := range limits {
+			if !value.IsNull() && !value.IsUnknown() && value.ValueInt64() != -1 {
+				diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("Attribute %s requires state tracking, it cannot be configured when state_type is none.", name),
+				)
+			}
+		}
+	}
+
+	// The connection rate is a number of connections per window
+	rateSet := !d.MaxSourceConnectionRate.IsNull() && d.MaxSourceConnectionRate.ValueInt64() != -1
+	windowSet := !d.MaxSourceConnectionRateWindow.IsNull() && d.MaxSourceConnectionRateWindow.ValueInt64() != -1
+	if !d.MaxSourceConnectionRate.IsUnknown() && !d.MaxSourceConnectionRateWindow.IsUnknown() && rateSet != windowSet {
+		diagnostics.AddAttributeError(
+			path.Root("max_source_connection_rate_window"),
+			"Invalid Attribute Combination",
+			"Attributes max_source_connection_rate and max_source_connection_rate_window must be configured together.",
+		)
+	}
+
+	return diagnostics
+}
+
+// firewallFilterDefaultSequence is the sequence of new rules without a
+// configured sequence.
+const firewallFilterDefaultSequence = 1
+
+// firewallFilterSequence returns the sequence to send for the rule, which is
+// only unknown when the rule is created without a configured sequence.
+func firewallFilterSequence(d *FirewallFilterResourceModel) int64 {
+	if d.Sequence.IsNull() || d.Sequence.IsUnknown() {
+		return firewallFilterDefaultSequence
+	}
+	return d.Sequence.ValueInt64()
+}
+
+// firewallFilterScheduleRegex matches the names OPNsense accepts for
+// schedules, or an empty name for rules without schedule.
+var firewallFilterScheduleRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{0,32}$`)
+
 func convertFirewallFilterSchemaToStruct(d *FirewallFilterResourceModel) (*firewall.Filter, error) {
 	// Parse 'Interface'
 	var interfaceList []string
 	d.Interface.ElementsAs(context.Background(), &interfaceList, false)
 
+	var tcpFlagList, tcpFlagOutOfList, icmpTypeList, icmpv6TypeList, categoryList []string
+	d.TCPFlags.ElementsAs(context.Background(), &tcpFlagList, false)
+	d.TCPFlagsOutOf.ElementsAs(context.Background(), &tcpFlagOutOfList, false)
+	d.ICMPTypes.ElementsAs(context.Background(), &icmpTypeList, false)
+	d.ICMPv6Types.ElementsAs(context.Background(), &icmpv6TypeList, false)
+	d.Categories.ElementsAs(context.Background(), &categoryList, false)
+
 	return &firewall.Filter{
 		Enabled:           tools.BoolToString(d.Enabled.ValueBool()),
-		Sequence:          tools.Int64ToString(d.Sequence.ValueInt64()),
+		Sequence:          tools.Int64ToString(firewallFilterSequence(d)),
 		Action:            api.SelectedMap(d.Action.ValueString()),
 		Quick:             tools.BoolToString(d.Quick.ValueBool()),
 		Interface:         interfaceList,
 		Direction:         api.SelectedMap(d.Direction.ValueString()),
 		IPProtocol:        api.SelectedMap(d.IPProtocol.ValueString()),
 		Protocol:          api.SelectedMap(d.Protocol.ValueString()),
-		SourceNet:         d.Source.Net.ValueString(),
+		SourceNet:         firewallFilterNetToString(d.Source.Net),
 		SourcePort:        tools.Int64ToStringNegative(d.Source.Port.ValueInt64()),
 		SourceInvert:      tools.BoolToString(d.Source.Invert.ValueBool()),
-		DestinationNet:    d.Destination.Net.ValueString(),
+		DestinationNet:    firewallFilterNetToString(d.Destination.Net),
 		DestinationPort:   tools.Int64ToStringNegative(d.Destination.Port.ValueInt64()),
 		DestinationInvert: tools.BoolToString(d.Destination.Invert.ValueBool()),
 		Gateway:           api.SelectedMap(d.Gateway.ValueString()),
+		Schedule:          api.SelectedMap(d.Schedule.ValueString()),
 		Log:               tools.BoolToString(d.Log.ValueBool()),
 		Description:       d.Description.ValueString(),
+
+		StateType:             api.SelectedMap(d.StateType.ValueString()),
+		StateTimeout:          tools.Int64ToStringNegative(d.StateTimeout.ValueInt64()),
+		MaxStates:             tools.Int64ToStringNegative(d.MaxStates.ValueInt64()),
+		MaxSourceNodes:        tools.Int64ToStringNegative(d.MaxSourceNodes.ValueInt64()),
+		MaxSourceStates:       tools.Int64ToStringNegative(d.MaxSourceStates.ValueInt64()),
+		MaxSourceConnections:  tools.Int64ToStringNegative(d.MaxSourceConnections.ValueInt64()),
+		MaxSourceConnRate:     tools.Int64ToStringNegative(d.MaxSourceConnectionRate.ValueInt64()),
+		MaxSourceConnRateSecs: tools.Int64ToStringNegative(d.MaxSourceConnectionRateWindow.ValueInt64()),
+		TCPFlags:              tcpFlagList,
+		TCPFlagsOutOf:         tcpFlagOutOfList,
+		ICMPTypes:             icmpTypeList,
+		ICMPv6Types:           icmpv6TypeList,
+		Tag:                   d.Tag.ValueString(),
+		Tagged:                d.Tagged.ValueString(),
+		AllowOptions:          tools.BoolToString(d.AllowOptions.ValueBool()),
+		NoSync:                tools.BoolToString(d.NoSync.ValueBool()),
+		Categories:            categoryList,
+		ReplyTo:               api.SelectedMap(d.ReplyTo.ValueString()),
 	}, nil
 }
 
@@ -340,19 +815,39 @@ func convertFirewallFilterStructToSchema(d *firewall.Filter) (*FirewallFilterRes
 		Direction:  types.StringValue(d.Direction.String()),
 		IPProtocol: types.StringValue(d.IPProtocol.String()),
 		Protocol:   types.StringValue(d.Protocol.String()),
-		Source: &firewallLocation{
-			Net:    types.StringValue(d.SourceNet),
+		Source: &firewallFilterLocation{
+			Net:    firewallFilterStringToNet(d.SourceNet),
 			Port:   types.Int64Value(tools.StringToInt64(d.SourcePort)),
 			Invert: types.BoolValue(tools.StringToBool(d.SourceInvert)),
 		},
-		Destination: &firewallLocation{
-			Net:    types.StringValue(d.DestinationNet),
+		Destination: &firewallFilterLocation{
+			Net:    firewallFilterStringToNet(d.DestinationNet),
 			Port:   types.Int64Value(tools.StringToInt64(d.DestinationPort)),
 			Invert: types.BoolValue(tools.StringToBool(d.DestinationInvert)),
 		},
 		Gateway:     types.StringValue(d.Gateway.String()),
+		Schedule:    types.StringValue(d.Schedule.String()),
 		Log:         types.BoolValue(tools.StringToBool(d.Log)),
 		Description: tools.StringOrNull(d.Description),
+
+		StateType:                     types.StringValue(d.StateType.String()),
+		StateTimeout:                  types.Int64Value(tools.StringToInt64(d.StateTimeout)),
+		MaxStates:                     types.Int64Value(tools.StringToInt64(d.MaxStates)),
+		MaxSourceNodes:                types.Int64Value(tools.StringToInt64(d.MaxSourceNodes)),
+		MaxSourceStates:               types.Int64Value(tools.StringToInt64(d.MaxSourceStates)),
+		MaxSourceConnections:          types.Int64Value(tools.StringToInt64(d.MaxSourceConnections)),
+		MaxSourceConnectionRate:       types.Int64Value(tools.StringToInt64(d.MaxSourceConnRate)),
+		MaxSourceConnectionRateWindow: types.Int64Value(tools.StringToInt64(d.MaxSourceConnRateSecs)),
+		TCPFlags:                      tools.StringSliceToSet(d.TCPFlags),
+		TCPFlagsOutOf:                 tools.StringSliceToSet(d.TCPFlagsOutOf),
+		ICMPTypes:                     tools.StringSliceToSet(d.ICMPTypes),
+		ICMPv6Types:                   tools.StringSliceToSet(d.ICMPv6Types),
+		Tag:                           tools.StringOrNull(d.Tag),
+		Tagged:                        tools.StringOrNull(d.Tagged),
+		AllowOptions:                  types.BoolValue(tools.StringToBool(d.AllowOptions)),
+		NoSync:                        types.BoolValue(tools.StringToBool(d.NoSync)),
+		Categories:                    tools.StringSliceToSet(d.Categories),
+		ReplyTo:                       tools.StringOrNull(d.ReplyTo.String()),
 	}
 
 	// Parse 'Interface'
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// firewallFilterAdvancedAttributes are the attributes added in version 1 of
+// the resource schema.
+var firewallFilterAdvancedAttributes = []string{
+	"state_type", "state_timeout", "max_states", "max_source_nodes", "max_source_states",
+	"max_source_connections", "max_source_connection_rate", "max_source_connection_rate_window",
+	"tcp_flags", "tcp_flags_out_of", "icmp_types", "icmpv6_types", "tag", "tagged", "allow_options",
+	"no_sync", "categories", "reply_to",
+}
+
+// firewallFilterResourceModelV0 describes version 0 of the resource data
+// model, before the advanced options were added.
+type firewallFilterResourceModelV0 struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Sequence types.Int64  `tfsdk:"sequence"`
+	Action   types.String `tfsdk:"action"`
+	Quick    types.Bool   `tfsdk:"quick"`
+
+	Interface types.Set    `tfsdk:"interface"`
+	Direction types.String `tfsdk:"direction"`
+
+	IPProtocol types.String `tfsdk:"ip_protocol"`
+	Protocol   types.String `tfsdk:"protocol"`
+
+	Source      *firewallLocation `tfsdk:"source"`
+	Destination *firewallLocation `tfsdk:"destination"`
+
+	Gateway  types.String `tfsdk:"gateway"`
+	Schedule types.String `tfsdk:"schedule"`
+	Log      types.Bool   `tfsdk:"log"`
+
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// firewallFilterResourceModelV1 describes version 1 of the resource data
+// model, before sources and destinations could match several networks.
+type firewallFilterResourceModelV1 struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Sequence types.Int64  `tfsdk:"sequence"`
+	Action   types.String `tfsdk:"action"`
+	Quick    types.Bool   `tfsdk:"quick"`
+
+	Interface types.Set    `tfsdk:"interface"`
+	Direction types.String `tfsdk:"direction"`
+
+	IPProtocol types.String `tfsdk:"ip_protocol"`
+	Protocol   types.String `tfsdk:"protocol"`
+
+	Source      *firewallLocation `tfsdk:"source"`
+	Destination *firewallLocation `tfsdk:"destination"`
+
+	Gateway  types.String `tfsdk:"gateway"`
+	Schedule types.String `tfsdk:"schedule"`
+	Log      types.Bool   `tfsdk:"log"`
+
+	Description types.String `tfsdk:"description"`
+
+	StateType                     types.String `tfsdk:"state_type"`
+	StateTimeout                  types.Int64  `tfsdk:"state_timeout"`
+	MaxStates                     types.Int64  `tfsdk:"max_states"`
+	MaxSourceNodes                types.Int64  `tfsdk:"max_source_nodes"`
+	MaxSourceStates               types.Int64  `tfsdk:"max_source_states"`
+	MaxSourceConnections          types.Int64  `tfsdk:"max_source_connections"`
+	MaxSourceConnectionRate       types.Int64  `tfsdk:"max_source_connection_rate"`
+	MaxSourceConnectionRateWindow types.Int64  `tfsdk:"max_source_connection_rate_window"`
+	TCPFlags                      types.Set    `tfsdk:"tcp_flags"`
+	TCPFlagsOutOf                 types.Set    `tfsdk:"tcp_flags_out_of"`
+	ICMPTypes                     types.Set    `tfsdk:"icmp_types"`
+	ICMPv6Types                   types.Set    `tfsdk:"icmpv6_types"`
+	Tag                           types.String `tfsdk:"tag"`
+	Tagged                        types.String `tfsdk:"tagged"`
+	AllowOptions                  types.Bool   `tfsdk:"allow_options"`
+	NoSync                        types.Bool   `tfsdk:"no_sync"`
+	Categories                    types.Set    `tfsdk:"categories"`
+	ReplyTo                       types.String `tfsdk:"reply_to"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// firewallFilterLocationSchemaV1 returns a source or destination attribute
+// as of version 1 of the resource schema, where `net` was a single string.
+// Only the attribute types matter when decoding prior state.
+func firewallFilterLocationSchemaV1() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"net": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"invert": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// firewallFilterResourceSchemaV1 returns version 1 of the resource schema.
+func firewallFilterResourceSchemaV1() schema.Schema {
+	priorSchema := FirewallFilterResourceSchema()
+	priorSchema.Version = 1
+	priorSchema.Attributes["source"] = firewallFilterLocationSchemaV1()
+	priorSchema.Attributes["destination"] = firewallFilterLocationSchemaV1()
+	return priorSchema
+}
+
+// firewallFilterResourceSchemaV0 returns version 0 of the resource schema,
+// which lacks the advanced options.
+func firewallFilterResourceSchemaV0() schema.Schema {
+	priorSchema := firewallFilterResourceSchemaV1()
+	priorSchema.Version = 0
+	for _, name := range firewallFilterAdvancedAttributes {
+		delete(priorSchema.Attributes, name)
+	}
+	return priorSchema
+}
+
+// upgradeFirewallFilterStateV0 sets the advanced options of a version 0 state
+// to their defaults, which match the behaviour of rules created before they
+// were added.
+func upgradeFirewallFilterStateV0(d *firewallFilterResourceModelV0) *FirewallFilterResourceModel {
+	schedule := d.Schedule
+	if schedule.IsNull() {
+		schedule = types.StringValue("")
+	}
+
+	return upgradeFirewallFilterStateV1(&firewallFilterResourceModelV1{
+		Enabled:     d.Enabled,
+		Sequence:    d.Sequence,
+		Action:      d.Action,
+		Quick:       d.Quick,
+		Interface:   d.Interface,
+		Direction:   d.Direction,
+		IPProtocol:  d.IPProtocol,
+		Protocol:    d.Protocol,
+		Source:      d.Source,
+		Destination: d.Destination,
+		Gateway:     d.Gateway,
+		Schedule:    schedule,
+		Log:         d.Log,
+		Description: d.Description,
+
+		StateType:                     types.StringValue("keep"),
+		StateTimeout:                  types.Int64Value(-1),
+		MaxStates:                     types.Int64Value(-1),
+		MaxSourceNodes:                types.Int64Value(-1),
+		MaxSourceStates:               types.Int64Value(-1),
+		MaxSourceConnections:          types.Int64Value(-1),
+		MaxSourceConnectionRate:       types.Int64Value(-1),
+		MaxSourceConnectionRateWindow: types.Int64Value(-1),
+		TCPFlags:                      tools.EmptySetValue(),
+		TCPFlagsOutOf:                 tools.EmptySetValue(),
+		ICMPTypes:                     tools.EmptySetValue(),
+		ICMPv6Types:                   tools.EmptySetValue(),
+		Tag:                           types.StringNull(),
+		Tagged:                        types.StringNull(),
+		AllowOptions:                  types.BoolValue(false),
+		NoSync:                        types.BoolValue(false),
+		Categories:                    tools.EmptySetValue(),
+		ReplyTo:                       types.StringNull(),
+
+		Id: d.Id,
+	})
+}
+
+// upgradeFirewallFilterStateV1 converts the single network of the sources
+// and destinations of a version 1 state into a set.
+func upgradeFirewallFilterStateV1(d *firewallFilterResourceModelV1) *FirewallFilterResourceModel {
+	upgradeLocation := func(l *firewallLocation) *firewallFilterLocation {
+		if l == nil {
+			return nil
+		}
+		return &firewallFilterLocation{
+			Net:    firewallFilterStringToNet(l.Net.ValueString()),
+			Port:   l.Port,
+			Invert: l.Invert,
+		}
+	}
+
+	return &FirewallFilterResourceModel{
+		Enabled:     d.Enabled,
+		Sequence:    d.Sequence,
+		Action:      d.Action,
+		Quick:       d.Quick,
+		Interface:   d.Interface,
+		Direction:   d.Direction,
+		IPProtocol:  d.IPProtocol,
+		Protocol:    d.Protocol,
+		Source:      upgradeLocation(d.Source),
+		Destination: upgradeLocation(d.Destination),
+		Gateway:     d.Gateway,
+		Schedule:    d.Schedule,
+		Log:         d.Log,
+		Description: d.Description,
+
+		StateType:                     d.StateType,
+		StateTimeout:                  d.StateTimeout,
+		MaxStates:                     d.MaxStates,
+		MaxSourceNodes:                d.MaxSourceNodes,
+		MaxSourceStates:               d.MaxSourceStates,
+		MaxSourceConnections:          d.MaxSourceConnections,
+		MaxSourceConnectionRate:       d.MaxSourceConnectionRate,
+		MaxSourceConnectionRateWindow: d.MaxSourceConnectionRateWindow,
+		TCPFlags:                      d.TCPFlags,
+		TCPFlagsOutOf:                 d.TCPFlagsOutOf,
+		ICMPTypes:                     d.ICMPTypes,
+		ICMPv6Types:                   d.ICMPv6Types,
+		Tag:                           d.Tag,
+		Tagged:                        d.Tagged,
+		AllowOptions:                  d.AllowOptions,
+		NoSync:                        d.NoSync,
+		Categories:                    d.Categories,
+		ReplyTo:                       d.ReplyTo,
+
+		Id: d.Id,
+	}
+}
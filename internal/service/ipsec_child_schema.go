@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/ipsec"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// IPsecChildResourceModel describes the resource data model.
+type IPsecChildResourceModel struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Connection     types.String `tfsdk:"connection"`
+	Description    types.String `tfsdk:"description"`
+	Mode           types.String `tfsdk:"mode"`
+	ESPProposals   types.Set    `tfsdk:"esp_proposals"`
+	SHA256_96      types.Bool   `tfsdk:"sha256_96"`
+	LocalNetworks  types.Set    `tfsdk:"local_networks"`
+	RemoteNetworks types.Set    `tfsdk:"remote_networks"`
+	Policies       types.Bool   `tfsdk:"policies"`
+	StartAction    types.String `tfsdk:"start_action"`
+	CloseAction    types.String `tfsdk:"close_action"`
+	DPDAction      types.String `tfsdk:"dpd_action"`
+	RequestId      types.Int64  `tfsdk:"request_id"`
+	RekeyTime      types.Int64  `tfsdk:"rekey_time"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ipsecChildResourceSchema() schema.Schema {
+	defaultProposals, _ := types.SetValue(types.StringType, []attr.Value{types.StringValue("default")})
+
+	return schema.Schema{
+		MarkdownDescription: "IPsec children define the ESP (phase 2) SAs of a connection, i.e. which traffic is sent through the tunnel.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this child. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "UUID of the connection (see `opnsense_ipsec_connection`) this child belongs to.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "IPsec mode. Available values: `tunnel`, `transport`, `pass`, `drop`. Defaults to `tunnel`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("tunnel"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("tunnel", "transport", "pass", "drop"),
+				},
+			},
+			"esp_proposals": schema.SetAttribute{
+				MarkdownDescription: "ESP proposals, each of an encryption algorithm, an integrity algorithm (unless using AEAD) and optionally a DH group for PFS, e.g. `aes256-sha256-modp2048` or `aes256gcm16`. Defaults to `[\"default\"]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(defaultProposals),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IsIPsecESPProposal()),
+				},
+			},
+			"sha256_96": schema.BoolAttribute{
+				MarkdownDescription: "Use the non-standard 96 bit truncation of SHA-256, for compatibility with older peers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"local_networks": schema.SetAttribute{
+				MarkdownDescription: "Local traffic selectors, in CIDR notation, e.g. `192.168.1.0/24`. Must specify at least 1.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"remote_networks": schema.SetAttribute{
+				MarkdownDescription: "Remote traffic selectors, in CIDR notation, e.g. `192.168.2.0/24`. Must specify at least 1.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"policies": schema.BoolAttribute{
+				MarkdownDescription: "Install IPsec policies. Disable for route based (VTI) tunnels. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"start_action": schema.StringAttribute{
+				MarkdownDescription: "Action to perform after loading the configuration. Available values: `none`, `trap|start`, `route`, `start`, `trap`. Defaults to `start`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("start"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "trap|start", "route", "start", "trap"),
+				},
+			},
+			"close_action": schema.StringAttribute{
+				MarkdownDescription: "Action to perform when the remote side closes the SA. Available values: `none`, `trap`, `start`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "trap", "start"),
+				},
+			},
+			"dpd_action": schema.StringAttribute{
+				MarkdownDescription: "Action to perform when the remote side is detected dead. Available values: `clear`, `trap`, `restart`. Defaults to `clear`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("clear"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("clear", "trap", "restart"),
+				},
+			},
+			"request_id": schema.Int64Attribute{
+				MarkdownDescription: "Fixed reqid of the SA, used to match route based (VTI) interfaces. Set to `-1` to assign one automatically. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"rekey_time": schema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to rekey the SA after. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the child.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func IPsecChildDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "IPsec children define the ESP (phase 2) SAs of a connection, i.e. which traffic is sent through the tunnel.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this child is enabled.",
+				Computed:            true,
+			},
+			"connection": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the connection this child belongs to.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"mode": dschema.StringAttribute{
+				MarkdownDescription: "IPsec mode.",
+				Computed:            true,
+			},
+			"esp_proposals": dschema.SetAttribute{
+				MarkdownDescription: "ESP proposals.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"sha256_96": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the 96 bit truncation of SHA-256 is used.",
+				Computed:            true,
+			},
+			"local_networks": dschema.SetAttribute{
+				MarkdownDescription: "Local traffic selectors.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"remote_networks": dschema.SetAttribute{
+				MarkdownDescription: "Remote traffic selectors.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"policies": dschema.BoolAttribute{
+				MarkdownDescription: "Whether IPsec policies are installed.",
+				Computed:            true,
+			},
+			"start_action": dschema.StringAttribute{
+				MarkdownDescription: "Action performed after loading the configuration.",
+				Computed:            true,
+			},
+			"close_action": dschema.StringAttribute{
+				MarkdownDescription: "Action performed when the remote side closes the SA.",
+				Computed:            true,
+			},
+			"dpd_action": dschema.StringAttribute{
+				MarkdownDescription: "Action performed when the remote side is detected dead.",
+				Computed:            true,
+			},
+			"request_id": dschema.Int64Attribute{
+				MarkdownDescription: "Fixed reqid of the SA. `-1` if assigned automatically.",
+				Computed:            true,
+			},
+			"rekey_time": dschema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to rekey the SA after. `-1` if the default is used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertIPsecChildSchemaToStruct(d *IPsecChildResourceModel) (*ipsec.Child, error) {
+	var proposalList, localNetworkList, remoteNetworkList []string
+
+	ctx := context.Background()
+	d.ESPProposals.ElementsAs(ctx, &proposalList, false)
+	d.LocalNetworks.ElementsAs(ctx, &localNetworkList, false)
+	d.RemoteNetworks.ElementsAs(ctx, &remoteNetworkList, false)
+
+	return &ipsec.Child{
+		Enabled:      tools.BoolToString(d.Enabled.ValueBool()),
+		Connection:   api.SelectedMap(d.Connection.ValueString()),
+		Description:  d.Description.ValueString(),
+		Mode:         api.SelectedMap(d.Mode.ValueString()),
+		ESPProposals: proposalList,
+		SHA256_96:    tools.BoolToString(d.SHA256_96.ValueBool()),
+		LocalTS:      localNetworkList,
+		RemoteTS:     remoteNetworkList,
+		Policies:     tools.BoolToString(d.Policies.ValueBool()),
+		StartAction:  api.SelectedMap(d.StartAction.ValueString()),
+		CloseAction:  api.SelectedMap(d.CloseAction.ValueString()),
+		DPDAction:    api.SelectedMap(d.DPDAction.ValueString()),
+		ReqId:        tools.Int64ToStringNegative(d.RequestId.ValueInt64()),
+		RekeyTime:    tools.Int64ToStringNegative(d.RekeyTime.ValueInt64()),
+	}, nil
+}
+
+func convertIPsecChildStructToSchema(d *ipsec.Child) (*IPsecChildResourceModel, error) {
+	return &IPsecChildResourceModel{
+		Enabled:        types.BoolValue(tools.StringToBool(d.Enabled)),
+		Connection:     types.StringValue(d.Connection.String()),
+		Description:    tools.StringOrNull(d.Description),
+		Mode:           types.StringValue(d.Mode.String()),
+		ESPProposals:   tools.StringSliceToSet(d.ESPProposals),
+		SHA256_96:      types.BoolValue(tools.StringToBool(d.SHA256_96)),
+		LocalNetworks:  tools.StringSliceToSet(d.LocalTS),
+		RemoteNetworks: tools.StringSliceToSet(d.RemoteTS),
+		Policies:       types.BoolValue(tools.StringToBool(d.Policies)),
+		StartAction:    types.StringValue(d.StartAction.String()),
+		CloseAction:    types.StringValue(d.CloseAction.String()),
+		DPDAction:      types.StringValue(d.DPDAction.String()),
+		RequestId:      types.Int64Value(tools.StringToInt64(d.ReqId)),
+		RekeyTime:      types.Int64Value(tools.StringToInt64(d.RekeyTime)),
+	}, nil
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/ipsec"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// ipsecVersions maps the IKE versions to the values used by OPNsense.
+var ipsecVersions = map[string]string{
+	"ike":   "0",
+	"ikev1": "1",
+	"ikev2": "2",
+}
+
+// IPsecConnectionResourceModel describes the resource data model.
+type IPsecConnectionResourceModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Description     types.String `tfsdk:"description"`
+	Version         types.String `tfsdk:"version"`
+	Proposals       types.Set    `tfsdk:"proposals"`
+	LocalAddresses  types.Set    `tfsdk:"local_addresses"`
+	LocalPort       types.Int64  `tfsdk:"local_port"`
+	RemoteAddresses types.Set    `tfsdk:"remote_addresses"`
+	RemotePort      types.Int64  `tfsdk:"remote_port"`
+	Unique          types.String `tfsdk:"unique"`
+	Aggressive      types.Bool   `tfsdk:"aggressive"`
+	Mobike          types.Bool   `tfsdk:"mobike"`
+	Encap           types.Bool   `tfsdk:"encap"`
+	ReauthTime      types.Int64  `tfsdk:"reauth_time"`
+	RekeyTime       types.Int64  `tfsdk:"rekey_time"`
+	OverTime        types.Int64  `tfsdk:"over_time"`
+	DPDDelay        types.Int64  `tfsdk:"dpd_delay"`
+	DPDTimeout      types.Int64  `tfsdk:"dpd_timeout"`
+	Pools           types.Set    `tfsdk:"pools"`
+	SendCertReq     types.Bool   `tfsdk:"send_cert_req"`
+	SendCert        types.String `tfsdk:"send_cert"`
+	KeyingTries     types.Int64  `tfsdk:"keying_tries"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ipsecConnectionResourceSchema() schema.Schema {
+	defaultProposals, _ := types.SetValue(types.StringType, []attr.Value{types.StringValue("default")})
+
+	return schema.Schema{
+		MarkdownDescription: "IPsec connections define the IKE (phase 1) parameters of a tunnel. Authentication and children (phase 2) are managed with `opnsense_ipsec_local_auth`, `opnsense_ipsec_remote_auth` and `opnsense_ipsec_child`, which reference the connection by UUID.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this connection. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "IKE version. Available values: `ike` (either), `ikev1`, `ikev2`. Defaults to `ikev2`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ikev2"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ike", "ikev1", "ikev2"),
+				},
+			},
+			"proposals": schema.SetAttribute{
+				MarkdownDescription: "IKE proposals, each of an encryption algorithm, an integrity algorithm (unless using AEAD) and a DH group, e.g. `aes256-sha256-modp2048` or `aes256gcm16-prfsha256-ecp256`. Defaults to `[\"default\"]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(defaultProposals),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IsIPsecIKEProposal()),
+				},
+			},
+			"local_addresses": schema.SetAttribute{
+				MarkdownDescription: "Local addresses to use for IKE communication. Uses any address when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"local_port": schema.Int64Attribute{
+				MarkdownDescription: "Local UDP port for IKE communication. Set to `-1` to use the default of 500. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"remote_addresses": schema.SetAttribute{
+				MarkdownDescription: "Remote addresses or hostnames to connect to. Accepts connections from any address when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"remote_port": schema.Int64Attribute{
+				MarkdownDescription: "Remote UDP port for IKE communication. Set to `-1` to use the default of 500. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"unique": schema.StringAttribute{
+				MarkdownDescription: "Policy for multiple SAs of the same peer. Available values: `no`, `never`, `keep`, `replace`. Defaults to `no`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("no"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("no", "never", "keep", "replace"),
+				},
+			},
+			"aggressive": schema.BoolAttribute{
+				MarkdownDescription: "Use aggressive mode instead of main mode (IKEv1 only). Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mobike": schema.BoolAttribute{
+				MarkdownDescription: "Enable MOBIKE (IKEv2 only). Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"encap": schema.BoolAttribute{
+				MarkdownDescription: "Force UDP encapsulation of ESP packets, even if no NAT is detected. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"reauth_time": schema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to reauthenticate the IKE SA after. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"rekey_time": schema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to rekey the IKE SA after. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"over_time": schema.Int64Attribute{
+				MarkdownDescription: "Hard IKE SA lifetime, in seconds, on top of `rekey_time` or `reauth_time`. Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"dpd_delay": schema.Int64Attribute{
+				MarkdownDescription: "Interval, in seconds, to check the liveness of the peer at. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"dpd_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout, in seconds, after which the peer is considered dead (IKEv1 only). Set to `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"pools": schema.SetAttribute{
+				MarkdownDescription: "Names of the pools to assign virtual IP addresses to remote clients from. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"send_cert_req": schema.BoolAttribute{
+				MarkdownDescription: "Send certificate requests in IKE messages. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"send_cert": schema.StringAttribute{
+				MarkdownDescription: "Send certificate payloads. Available values: `ifasked`, `never`, `always`. Uses `ifasked` when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ifasked", "never", "always"),
+				},
+			},
+			"keying_tries": schema.Int64Attribute{
+				MarkdownDescription: "Number of retransmission sequences to perform during initial connect. Set to `0` to retry forever, or `-1` to use the default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func IPsecConnectionDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "IPsec connections define the IKE (phase 1) parameters of a tunnel.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this connection is enabled.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"version": dschema.StringAttribute{
+				MarkdownDescription: "IKE version.",
+				Computed:            true,
+			},
+			"proposals": dschema.SetAttribute{
+				MarkdownDescription: "IKE proposals.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"local_addresses": dschema.SetAttribute{
+				MarkdownDescription: "Local addresses used for IKE communication.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"local_port": dschema.Int64Attribute{
+				MarkdownDescription: "Local UDP port for IKE communication. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"remote_addresses": dschema.SetAttribute{
+				MarkdownDescription: "Remote addresses or hostnames.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"remote_port": dschema.Int64Attribute{
+				MarkdownDescription: "Remote UDP port for IKE communication. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"unique": dschema.StringAttribute{
+				MarkdownDescription: "Policy for multiple SAs of the same peer.",
+				Computed:            true,
+			},
+			"aggressive": dschema.BoolAttribute{
+				MarkdownDescription: "Whether aggressive mode is used.",
+				Computed:            true,
+			},
+			"mobike": dschema.BoolAttribute{
+				MarkdownDescription: "Whether MOBIKE is enabled.",
+				Computed:            true,
+			},
+			"encap": dschema.BoolAttribute{
+				MarkdownDescription: "Whether UDP encapsulation of ESP packets is forced.",
+				Computed:            true,
+			},
+			"reauth_time": dschema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to reauthenticate the IKE SA after. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"rekey_time": dschema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, to rekey the IKE SA after. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"over_time": dschema.Int64Attribute{
+				MarkdownDescription: "Hard IKE SA lifetime, in seconds. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"dpd_delay": dschema.Int64Attribute{
+				MarkdownDescription: "Interval, in seconds, to check the liveness of the peer at. `-1` if disabled.",
+				Computed:            true,
+			},
+			"dpd_timeout": dschema.Int64Attribute{
+				MarkdownDescription: "Timeout, in seconds, after which the peer is considered dead. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"pools": dschema.SetAttribute{
+				MarkdownDescription: "Names of the pools to assign virtual IP addresses from.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"send_cert_req": dschema.BoolAttribute{
+				MarkdownDescription: "Whether certificate requests are sent.",
+				Computed:            true,
+			},
+			"send_cert": dschema.StringAttribute{
+				MarkdownDescription: "When certificate payloads are sent.",
+				Computed:            true,
+			},
+			"keying_tries": dschema.Int64Attribute{
+				MarkdownDescription: "Number of retransmission sequences during initial connect. `-1` if the default is used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertIPsecConnectionSchemaToStruct(d *IPsecConnectionResourceModel) (*ipsec.Connection, error) {
+	var proposalList, localAddressList, remoteAddressList, poolList []string
+
+	ctx := context.Background()
+	d.Proposals.ElementsAs(ctx, &proposalList, false)
+	d.LocalAddresses.ElementsAs(ctx, &localAddressList, false)
+	d.RemoteAddresses.ElementsAs(ctx, &remoteAddressList, false)
+	d.Pools.ElementsAs(ctx, &poolList, false)
+
+	return &ipsec.Connection{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Description: d.Description.ValueString(),
+		Version:     api.SelectedMap(ipsecVersions[d.Version.ValueString()]),
+		Proposals:   proposalList,
+		LocalAddrs:  localAddressList,
+		LocalPort:   tools.Int64ToStringNegative(d.LocalPort.ValueInt64()),
+		RemoteAddrs: remoteAddressList,
+		RemotePort:  tools.Int64ToStringNegative(d.RemotePort.ValueInt64()),
+		Unique:      api.SelectedMap(d.Unique.ValueString()),
+		Aggressive:  tools.BoolToString(d.Aggressive.ValueBool()),
+		Mobike:      tools.BoolToString(d.Mobike.ValueBool()),
+		Encap:       tools.BoolToString(d.Encap.ValueBool()),
+		ReauthTime:  tools.Int64ToStringNegative(d.ReauthTime.ValueInt64()),
+		RekeyTime:   tools.Int64ToStringNegative(d.RekeyTime.ValueInt64()),
+		OverTime:    tools.Int64ToStringNegative(d.OverTime.ValueInt64()),
+		DPDDelay:    tools.Int64ToStringNegative(d.DPDDelay.ValueInt64()),
+		DPDTimeout:  tools.Int64ToStringNegative(d.DPDTimeout.ValueInt64()),
+		Pools:       poolList,
+		SendCertReq: tools.BoolToString(d.SendCertReq.ValueBool()),
+		SendCert:    api.SelectedMap(d.SendCert.ValueString()),
+		KeyingTries: tools.Int64ToStringNegative(d.KeyingTries.ValueInt64()),
+	}, nil
+}
+
+func convertIPsecConnectionStructToSchema(d *ipsec.Connection) (*IPsecConnectionResourceModel, error) {
+	version := "ike"
+	for name, value := range ipsecVersions {
+		if value == d.Version.String() {
+			version = name
+		}
+	}
+
+	return &IPsecConnectionResourceModel{
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:     tools.StringOrNull(d.Description),
+		Version:         types.StringValue(version),
+		Proposals:       tools.StringSliceToSet(d.Proposals),
+		LocalAddresses:  tools.StringSliceToSet(d.LocalAddrs),
+		LocalPort:       types.Int64Value(tools.StringToInt64(d.LocalPort)),
+		RemoteAddresses: tools.StringSliceToSet(d.RemoteAddrs),
+		RemotePort:      types.Int64Value(tools.StringToInt64(d.RemotePort)),
+		Unique:          types.StringValue(d.Unique.String()),
+		Aggressive:      types.BoolValue(tools.StringToBool(d.Aggressive)),
+		Mobike:          types.BoolValue(tools.StringToBool(d.Mobike)),
+		Encap:           types.BoolValue(tools.StringToBool(d.Encap)),
+		ReauthTime:      types.Int64Value(tools.StringToInt64(d.ReauthTime)),
+		RekeyTime:       types.Int64Value(tools.StringToInt64(d.RekeyTime)),
+		OverTime:        types.Int64Value(tools.StringToInt64(d.OverTime)),
+		DPDDelay:        types.Int64Value(tools.StringToInt64(d.DPDDelay)),
+		DPDTimeout:      types.Int64Value(tools.StringToInt64(d.DPDTimeout)),
+		Pools:           tools.StringSliceToSet(d.Pools),
+		SendCertReq:     types.BoolValue(tools.StringToBool(d.SendCertReq)),
+		SendCert:        tools.StringOrNull(d.SendCert.String()),
+		KeyingTries:     types.Int64Value(tools.StringToInt64(d.KeyingTries)),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/ipsec"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// IPsecPSKResourceModel describes the resource data model.
+type IPsecPSKResourceModel struct {
+	Identity       types.String `tfsdk:"identity"`
+	RemoteIdentity types.String `tfsdk:"remote_identity"`
+	Type           types.String `tfsdk:"type"`
+	Key            types.String `tfsdk:"key"`
+	Description    types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ipsecPSKResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Pre-shared keys are used by IPsec connections with `psk` authentication, matched by the local and remote identities.",
+
+		Attributes: map[string]schema.Attribute{
+			"identity": schema.StringAttribute{
+				MarkdownDescription: "Local identity the key applies to, e.g. an IP address or FQDN.",
+				Required:            true,
+			},
+			"remote_identity": schema.StringAttribute{
+				MarkdownDescription: "Remote identity the key applies to. Applies to any remote identity when not set.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the key. Available values: `PSK`, `EAP`. Defaults to `PSK`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("PSK"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("PSK", "EAP"),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The pre-shared key.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the pre-shared key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func IPsecPSKDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Pre-shared keys are used by IPsec connections with `psk` authentication, matched by the local and remote identities.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"identity": dschema.StringAttribute{
+				MarkdownDescription: "Local identity the key applies to.",
+				Computed:            true,
+			},
+			"remote_identity": dschema.StringAttribute{
+				MarkdownDescription: "Remote identity the key applies to.",
+				Computed:            true,
+			},
+			"type": dschema.StringAttribute{
+				MarkdownDescription: "Type of the key.",
+				Computed:            true,
+			},
+			"key": dschema.StringAttribute{
+				MarkdownDescription: "The pre-shared key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertIPsecPSKSchemaToStruct(d *IPsecPSKResourceModel) (*ipsec.PSK, error) {
+	return &ipsec.PSK{
+		Identity:       d.Identity.ValueString(),
+		RemoteIdentity: d.RemoteIdentity.ValueString(),
+		KeyType:        api.SelectedMap(d.Type.ValueString()),
+		Key:            d.Key.ValueString(),
+		Description:    d.Description.ValueString(),
+	}, nil
+}
+
+func convertIPsecPSKStructToSchema(d *ipsec.PSK) (*IPsecPSKResourceModel, error) {
+	return &IPsecPSKResourceModel{
+		Identity:       types.StringValue(d.Identity),
+		RemoteIdentity: tools.StringOrNull(d.RemoteIdentity),
+		Type:           types.StringValue(d.KeyType.String()),
+		Key:            types.StringValue(d.Key),
+		Description:    tools.StringOrNull(d.Description),
+	}, nil
+}
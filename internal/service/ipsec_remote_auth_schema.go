@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/ipsec"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// IPsecRemoteAuthResourceModel describes the resource data model.
+type IPsecRemoteAuthResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Connection  types.String `tfsdk:"connection"`
+	Round       types.Int64  `tfsdk:"round"`
+	Auth        types.String `tfsdk:"auth"`
+	Identity    types.String `tfsdk:"identity"`
+	EAPIdentity types.String `tfsdk:"eap_identity"`
+	Groups      types.Set    `tfsdk:"groups"`
+	Certs       types.Set    `tfsdk:"certs"`
+	CACerts     types.Set    `tfsdk:"ca_certs"`
+	PublicKeys  types.Set    `tfsdk:"public_keys"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func ipsecRemoteAuthResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Remote authentication defines how the remote side of an IPsec connection must authenticate itself to this firewall.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this authentication. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "UUID of the connection (see `opnsense_ipsec_connection`) this authentication belongs to.",
+				Required:            true,
+			},
+			"round": schema.Int64Attribute{
+				MarkdownDescription: "Authentication round, to use multiple authentication rounds. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"auth": schema.StringAttribute{
+				MarkdownDescription: "Authentication method. Available values: `psk`, `pubkey`, `eap-tls`, `eap-mschapv2`, `xauth-pam`, `eap-radius`. Defaults to `psk`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("psk"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ipsecAuthMethods...),
+				},
+			},
+			"identity": schema.StringAttribute{
+				MarkdownDescription: "IKE identity, e.g. an IP address, FQDN or distinguished name. Accepts any identity when not set.",
+				Optional:            true,
+			},
+			"eap_identity": schema.StringAttribute{
+				MarkdownDescription: "EAP identity, when using an EAP authentication method.",
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the groups the remote user must be a member of, when using EAP or XAuth. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"ca_certs": schema.SetAttribute{
+				MarkdownDescription: "Reference IDs of the certificate authorities the certificate of the remote side must be issued by. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"certs": schema.SetAttribute{
+				MarkdownDescription: "Reference IDs of the certificates the remote side must authenticate with, when `auth` is `pubkey`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"public_keys": schema.SetAttribute{
+				MarkdownDescription: "UUIDs of the raw public keys the remote side must authenticate with, when `auth` is `pubkey`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the remote authentication.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func IPsecRemoteAuthDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Remote authentication defines how the remote side of an IPsec connection must authenticate itself to this firewall.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this authentication is enabled.",
+				Computed:            true,
+			},
+			"connection": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the connection this authentication belongs to.",
+				Computed:            true,
+			},
+			"round": dschema.Int64Attribute{
+				MarkdownDescription: "Authentication round.",
+				Computed:            true,
+			},
+			"auth": dschema.StringAttribute{
+				MarkdownDescription: "Authentication method.",
+				Computed:            true,
+			},
+			"identity": dschema.StringAttribute{
+				MarkdownDescription: "IKE identity.",
+				Computed:            true,
+			},
+			"eap_identity": dschema.StringAttribute{
+				MarkdownDescription: "EAP identity.",
+				Computed:            true,
+			},
+			"groups": dschema.SetAttribute{
+				MarkdownDescription: "Names of the groups the remote user must be a member of.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"ca_certs": dschema.SetAttribute{
+				MarkdownDescription: "Reference IDs of the certificate authorities the certificate of the remote side must be issued by.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"certs": dschema.SetAttribute{
+				MarkdownDescription: "Reference IDs of the certificates the remote side must authenticate with.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"public_keys": dschema.SetAttribute{
+				MarkdownDescription: "UUIDs of the raw public keys the remote side must authenticate with.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertIPsecRemoteAuthSchemaToStruct(d *IPsecRemoteAuthResourceModel) (*ipsec.RemoteAuth, error) {
+	var groupList, certList, caCertList, publicKeyList []string
+
+	ctx := context.Background()
+	d.Groups.ElementsAs(ctx, &groupList, false)
+	d.CACerts.ElementsAs(ctx, &caCertList, false)
+	d.Certs.ElementsAs(ctx, &certList, false)
+	d.PublicKeys.ElementsAs(ctx, &publicKeyList, false)
+
+	return &ipsec.RemoteAuth{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Connection:  api.SelectedMap(d.Connection.ValueString()),
+		Round:       tools.Int64ToString(d.Round.ValueInt64()),
+		Auth:        api.SelectedMap(d.Auth.ValueString()),
+		Id:          d.Identity.ValueString(),
+		EAPId:       d.EAPIdentity.ValueString(),
+		Groups:      groupList,
+		Certs:       certList,
+		CACerts:     caCertList,
+		PubKeys:     publicKeyList,
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertIPsecRemoteAuthStructToSchema(d *ipsec.RemoteAuth) (*IPsecRemoteAuthResourceModel, error) {
+	return &IPsecRemoteAuthResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Connection:  types.StringValue(d.Connection.String()),
+		Round:       types.Int64Value(tools.StringToInt64(d.Round)),
+		Auth:        types.StringValue(d.Auth.String()),
+		Identity:    tools.StringOrNull(d.Id),
+		EAPIdentity: tools.StringOrNull(d.EAPId),
+		Groups:      tools.StringSliceToSet(d.Groups),
+		Certs:       tools.StringSliceToSet(d.Certs),
+		CACerts:     tools.StringSliceToSet(d.CACerts),
+		PublicKeys:  tools.StringSliceToSet(d.PubKeys),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
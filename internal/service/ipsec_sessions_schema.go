@@ -0,0 +1,127 @@
+package service
+
+import (
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/ipsec"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type ipsecSession struct {
+	Connection     types.String `tfsdk:"connection"`
+	Description    types.String `tfsdk:"description"`
+	Connected      types.Bool   `tfsdk:"connected"`
+	Version        types.String `tfsdk:"version"`
+	LocalAddress   types.String `tfsdk:"local_address"`
+	RemoteAddress  types.String `tfsdk:"remote_address"`
+	LocalIdentity  types.String `tfsdk:"local_identity"`
+	RemoteIdentity types.String `tfsdk:"remote_identity"`
+	InstallTime    types.Int64  `tfsdk:"install_time"`
+	BytesIn        types.Int64  `tfsdk:"bytes_in"`
+	BytesOut       types.Int64  `tfsdk:"bytes_out"`
+}
+
+// IPsecSessionsDataSourceModel describes the data source data model.
+type IPsecSessionsDataSourceModel struct {
+	Connection types.String   `tfsdk:"connection"`
+	Sessions   []ipsecSession `tfsdk:"sessions"`
+}
+
+func IPsecSessionsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Lists the status of the IKE (phase 1) SAs of the configured IPsec connections.",
+
+		Attributes: map[string]dschema.Attribute{
+			"connection": dschema.StringAttribute{
+				MarkdownDescription: "Only list the SA of this connection, by UUID (see `opnsense_ipsec_connection`). Lists all SAs when not set.",
+				Optional:            true,
+			},
+			"sessions": dschema.ListNestedAttribute{
+				MarkdownDescription: "List of SAs, sorted by `connection`.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"connection": dschema.StringAttribute{
+							MarkdownDescription: "UUID of the connection.",
+							Computed:            true,
+						},
+						"description": dschema.StringAttribute{
+							MarkdownDescription: "Description of the connection.",
+							Computed:            true,
+						},
+						"connected": dschema.BoolAttribute{
+							MarkdownDescription: "Whether the SA is established.",
+							Computed:            true,
+						},
+						"version": dschema.StringAttribute{
+							MarkdownDescription: "IKE version of the SA, e.g. `IKEv2`.",
+							Computed:            true,
+						},
+						"local_address": dschema.StringAttribute{
+							MarkdownDescription: "Local address of the SA.",
+							Computed:            true,
+						},
+						"remote_address": dschema.StringAttribute{
+							MarkdownDescription: "Remote address of the SA.",
+							Computed:            true,
+						},
+						"local_identity": dschema.StringAttribute{
+							MarkdownDescription: "Local identity of the SA.",
+							Computed:            true,
+						},
+						"remote_identity": dschema.StringAttribute{
+							MarkdownDescription: "Remote identity of the SA.",
+							Computed:            true,
+						},
+						"install_time": dschema.Int64Attribute{
+							MarkdownDescription: "Time, in seconds, since the SA was established.",
+							Computed:            true,
+						},
+						"bytes_in": dschema.Int64Attribute{
+							MarkdownDescription: "Number of bytes received by the children of the SA.",
+							Computed:            true,
+						},
+						"bytes_out": dschema.Int64Attribute{
+							MarkdownDescription: "Number of bytes sent by the children of the SA.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertIPsecSessionsStructToSchema(connection types.String, sessions []ipsec.Phase1) (*IPsecSessionsDataSourceModel, error) {
+	model := &IPsecSessionsDataSourceModel{
+		Connection: connection,
+		Sessions:   []ipsecSession{},
+	}
+
+	for _, session := range sessions {
+		if !connection.IsNull() && session.Name.String() != connection.ValueString() {
+			continue
+		}
+
+		model.Sessions = append(model.Sessions, ipsecSession{
+			Connection:     types.StringValue(session.Name.String()),
+			Description:    tools.StringOrNull(session.Description.String()),
+			Connected:      types.BoolValue(tools.StringToBool(session.Connected.String())),
+			Version:        tools.StringOrNull(session.Version.String()),
+			LocalAddress:   tools.StringOrNull(session.LocalAddrs.String()),
+			RemoteAddress:  tools.StringOrNull(session.RemoteAddrs.String()),
+			LocalIdentity:  tools.StringOrNull(session.LocalId.String()),
+			RemoteIdentity: tools.StringOrNull(session.RemoteId.String()),
+			InstallTime:    tools.StringToInt64Null(session.InstallTime.String()),
+			BytesIn:        tools.StringToInt64Null(session.BytesIn.String()),
+			BytesOut:       tools.StringToInt64Null(session.BytesOut.String()),
+		})
+	}
+
+	sort.SliceStable(model.Sessions, func(i, j int) bool {
+		return model.Sessions[i].Connection.ValueString() < model.Sessions[j].Connection.ValueString()
+	})
+
+	return model, nil
+}
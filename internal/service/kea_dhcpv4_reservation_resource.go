@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KeaDhcpv4ReservationResource{}
+var _ resource.ResourceWithImportState = &KeaDhcpv4ReservationResource{}
+var _ resource.ResourceWithModifyPlan = &KeaDhcpv4ReservationResource{}
+
+func NewKeaDhcpv4ReservationResource() resource.Resource {
+	return &KeaDhcpv4ReservationResource{}
+}
+
+// KeaDhcpv4ReservationResource defines the resource implementation.
+type KeaDhcpv4ReservationResource struct {
+	client opnsense.Client
+}
+
+func (r *KeaDhcpv4ReservationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kea_dhcpv4_reservation"
+}
+
+func (r *KeaDhcpv4ReservationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = keaDhcpv4ReservationResourceSchema()
+}
+
+func (r *KeaDhcpv4ReservationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *KeaDhcpv4ReservationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data *KeaDhcpv4ReservationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The subnet may not exist yet, in which case the check runs at apply time
+	if data.Subnet.IsUnknown() || data.IPAddress.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkIPInSubnet(ctx, data)...)
+}
+
+func (r *KeaDhcpv4ReservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *KeaDhcpv4ReservationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure the address belongs to the parent subnet
+	resp.Diagnostics.Append(r.checkIPInSubnet(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	reservation, err := convertKeaDhcpv4ReservationSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse reservation, got error: %s", err))
+		return
+	}
+
+	// Add reservation to Kea
+	id, err := r.client.Kea().AddDhcpv4Reservation(ctx, reservation)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create reservation, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeaDhcpv4ReservationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *KeaDhcpv4ReservationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get reservation from OPNsense Kea API
+	reservation, err := r.client.Kea().GetDhcpv4Reservation(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("reservation not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read reservation, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	reservationModel, err := convertKeaDhcpv4ReservationStructToSchema(reservation)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read reservation, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	reservationModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &reservationModel)...)
+}
+
+func (r *KeaDhcpv4ReservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *KeaDhcpv4ReservationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure the address belongs to the parent subnet
+	resp.Diagnostics.Append(r.checkIPInSubnet(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	reservation, err := convertKeaDhcpv4ReservationSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse reservation, got error: %s", err))
+		return
+	}
+
+	// Update reservation in Kea
+	err = r.client.Kea().UpdateDhcpv4Reservation(ctx, data.Id.ValueString(), reservation)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update reservation, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeaDhcpv4ReservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *KeaDhcpv4ReservationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Kea().DeleteDhcpv4Reservation(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete reservation, got error: %s", err))
+		return
+	}
+}
+
+func (r *KeaDhcpv4ReservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkIPInSubnet verifies that the reservation's IP address is part of the
+// CIDR of the subnet it is attached to.
+func (r *KeaDhcpv4ReservationResource) checkIPInSubnet(ctx context.Context, data *KeaDhcpv4ReservationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	subnet, err := r.client.Kea().GetDhcpv4Subnet(ctx, data.Subnet.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("subnet"), "Client Error",
+			fmt.Sprintf("Unable to read subnet %s, got error: %s", data.Subnet.ValueString(), err))
+		return diags
+	}
+
+	_, network, err := net.ParseCIDR(subnet.Subnet)
+	if err != nil {
+		diags.AddAttributeError(path.Root("subnet"), "Invalid Subnet",
+			fmt.Sprintf("Unable to parse subnet %s, got error: %s", subnet.Subnet, err))
+		return diags
+	}
+
+	if ip := net.ParseIP(data.IPAddress.ValueString()); ip == nil || !network.Contains(ip) {
+		diags.AddAttributeError(path.Root("ip_address"), "Invalid IP Address",
+			fmt.Sprintf("Attribute ip_address must be inside subnet %s, got: %s", subnet.Subnet, data.IPAddress.ValueString()))
+	}
+
+	return diags
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// KeaDhcpv4ReservationResourceModel describes the resource data model.
+type KeaDhcpv4ReservationResourceModel struct {
+	Subnet      types.String `tfsdk:"subnet"`
+	IPAddress   types.String `tfsdk:"ip_address"`
+	MACAddress  types.String `tfsdk:"mac_address"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaDhcpv4ReservationResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Reservations assign a fixed IP address (and optionally a hostname) to a client of the Kea DHCPv4 server, identified by its MAC address.\n\n-> Changes are not applied until Kea is reconfigured. Include this resource in the `triggers` of `opnsense_kea_reconfigure`, so that in-place updates are applied too.",
+
+		Attributes: map[string]schema.Attribute{
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "UUID of the subnet this reservation belongs to.",
+				Required:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IP address to assign to the client. Must be inside the subnet.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IsIP(),
+				},
+			},
+			"mac_address": schema.StringAttribute{
+				MarkdownDescription: "MAC address of the client, e.g. `00:11:22:33:44:55`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IsMAC(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname to offer to the client.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the reservation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func KeaDhcpv4ReservationDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Reservations assign a fixed IP address (and optionally a hostname) to a client of the Kea DHCPv4 server, identified by its MAC address.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"subnet": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the subnet this reservation belongs to.",
+				Computed:            true,
+			},
+			"ip_address": dschema.StringAttribute{
+				MarkdownDescription: "IP address assigned to the client.",
+				Computed:            true,
+			},
+			"mac_address": dschema.StringAttribute{
+				MarkdownDescription: "MAC address of the client.",
+				Computed:            true,
+			},
+			"hostname": dschema.StringAttribute{
+				MarkdownDescription: "Hostname offered to the client.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertKeaDhcpv4ReservationSchemaToStruct(d *KeaDhcpv4ReservationResourceModel) (*kea.Dhcpv4Reservation, error) {
+	return &kea.Dhcpv4Reservation{
+		Subnet:      api.SelectedMap(d.Subnet.ValueString()),
+		IPAddress:   d.IPAddress.ValueString(),
+		HWAddress:   d.MACAddress.ValueString(),
+		Hostname:    d.Hostname.ValueString(),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertKeaDhcpv4ReservationStructToSchema(d *kea.Dhcpv4Reservation) (*KeaDhcpv4ReservationResourceModel, error) {
+	return &KeaDhcpv4ReservationResourceModel{
+		Subnet:      types.StringValue(d.Subnet.String()),
+		IPAddress:   types.StringValue(d.IPAddress),
+		MACAddress:  types.StringValue(d.HWAddress),
+		Hostname:    tools.StringOrNull(d.Hostname),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
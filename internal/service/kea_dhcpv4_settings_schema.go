@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// keaDhcpv4SettingsId is the ID of the (singleton) DHCPv4 settings.
+const keaDhcpv4SettingsId = "dhcpv4"
+
+// KeaDhcpv4SettingsResourceModel describes the resource data model.
+type KeaDhcpv4SettingsResourceModel struct {
+	Enabled       types.Bool  `tfsdk:"enabled"`
+	Interfaces    types.Set   `tfsdk:"interfaces"`
+	ValidLifetime types.Int64 `tfsdk:"valid_lifetime"`
+	FirewallRules types.Bool  `tfsdk:"firewall_rules"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaDhcpv4SettingsResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "General settings of the Kea DHCPv4 server, including the interfaces it listens on. Kea serves each subnet (see `opnsense_kea_dhcpv4_subnet`) on the listening interface with an address inside it, so the interface of a new VLAN must be added here before it gets DHCP. There is only one such configuration per OPNsense host, destroying this resource disables the DHCPv4 server and clears its interfaces.\n\n-> Changes are not applied until Kea is reconfigured. Include this resource in the `triggers` of `opnsense_kea_reconfigure`, so that in-place updates are applied too.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the DHCPv4 server. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: "Set of interfaces to listen on, by their OPNsense identifier (e.g. `[\"lan\", \"opt1\"]`). A VLAN (see `opnsense_interfaces_vlan`) must be assigned to an interface to be listed here. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Lifetime of the leases handed out, in seconds. Defaults to `4000`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(4000),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"firewall_rules": schema.BoolAttribute{
+				MarkdownDescription: "Automatically add the firewall rules allowing DHCP traffic on the listening interfaces. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the DHCPv4 settings. Always `dhcpv4`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func convertKeaDhcpv4SettingsSchemaToStruct(d *KeaDhcpv4SettingsResourceModel) (*kea.Dhcpv4Settings, error) {
+	// Parse 'Interfaces'
+	var interfacesList []string
+	d.Interfaces.ElementsAs(context.Background(), &interfacesList, false)
+
+	return &kea.Dhcpv4Settings{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Interfaces:    interfacesList,
+		ValidLifetime: tools.Int64ToString(d.ValidLifetime.ValueInt64()),
+		FirewallRules: tools.BoolToString(d.FirewallRules.ValueBool()),
+	}, nil
+}
+
+func convertKeaDhcpv4SettingsStructToSchema(d *kea.Dhcpv4Settings) (*KeaDhcpv4SettingsResourceModel, error) {
+	return &KeaDhcpv4SettingsResourceModel{
+		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
+		Interfaces:    tools.StringSliceToSet(d.Interfaces),
+		ValidLifetime: types.Int64Value(tools.StringToInt64(d.ValidLifetime)),
+		FirewallRules: types.BoolValue(tools.StringToBool(d.FirewallRules)),
+	}, nil
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/kea"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// KeaDhcpv4SubnetResourceModel describes the resource data model.
+type KeaDhcpv4SubnetResourceModel struct {
+	Subnet      types.String `tfsdk:"subnet"`
+	Pools       types.List   `tfsdk:"pools"`
+	NextServer  types.String `tfsdk:"next_server"`
+	Description types.String `tfsdk:"description"`
+
+	AutoCollect  types.Bool   `tfsdk:"auto_collect"`
+	Routers      types.List   `tfsdk:"routers"`
+	DNSServers   types.List   `tfsdk:"dns_servers"`
+	DomainName   types.String `tfsdk:"domain_name"`
+	DomainSearch types.List   `tfsdk:"domain_search"`
+	NTPServers   types.List   `tfsdk:"ntp_servers"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaDhcpv4SubnetResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Subnets define the address ranges and options the Kea DHCPv4 server hands out to clients. Kea serves the subnet on the listening interface with an address inside it, see `interfaces` of `opnsense_kea_dhcpv4_settings`.\n\n-> Changes are not applied until Kea is reconfigured. Include this resource in the `triggers` of `opnsense_kea_reconfigure`, so that in-place updates are applied too.",
+
+		Attributes: map[string]schema.Attribute{
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "Subnet to serve, in CIDR notation (e.g. `192.168.1.0/24`).",
+				Required:            true,
+				Validators: []validator.String{
+					validators.IsCIDR(),
+				},
+			},
+			"pools": schema.ListAttribute{
+				MarkdownDescription: "List of address pools to hand out dynamically, as a range or in CIDR notation (e.g. `[\"192.168.1.100-192.168.1.199\"]`). Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+			},
+			"next_server": schema.StringAttribute{
+				MarkdownDescription: "IP address of the next server in the boot process (e.g. a TFTP server). Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"auto_collect": schema.BoolAttribute{
+				MarkdownDescription: "Automatically collect the router and DNS server options from the interface serving this subnet. Must be `false` when setting `routers` or `dns_servers`. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"routers": schema.ListAttribute{
+				MarkdownDescription: "List of default gateways to offer to clients, in order of preference. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IsIP()),
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "List of DNS servers to offer to clients, in order of preference. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IsIP()),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				MarkdownDescription: "Domain name to offer to clients. Set to `\"\"` to use the system domain. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"domain_search": schema.ListAttribute{
+				MarkdownDescription: "List of domains to offer to clients as DNS search list. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+			},
+			"ntp_servers": schema.ListAttribute{
+				MarkdownDescription: "List of NTP servers to offer to clients. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IsIP()),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the subnet.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func KeaDhcpv4SubnetDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Subnets define the address ranges and options the Kea DHCPv4 server hands out to clients.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"subnet": dschema.StringAttribute{
+				MarkdownDescription: "Subnet to serve, in CIDR notation.",
+				Computed:            true,
+			},
+			"pools": dschema.ListAttribute{
+				MarkdownDescription: "List of address pools to hand out dynamically.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"next_server": dschema.StringAttribute{
+				MarkdownDescription: "IP address of the next server in the boot process.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"auto_collect": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the router and DNS server options are collected from the interface serving this subnet.",
+				Computed:            true,
+			},
+			"routers": dschema.ListAttribute{
+				MarkdownDescription: "List of default gateways offered to clients.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dns_servers": dschema.ListAttribute{
+				MarkdownDescription: "List of DNS servers offered to clients.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"domain_name": dschema.StringAttribute{
+				MarkdownDescription: "Domain name offered to clients.",
+				Computed:            true,
+			},
+			"domain_search": dschema.ListAttribute{
+				MarkdownDescription: "List of domains offered to clients as DNS search list.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"ntp_servers": dschema.ListAttribute{
+				MarkdownDescription: "List of NTP servers offered to clients.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// validateKeaDhcpv4SubnetConfig ensures routers and DNS servers are only set
+// when they are not collected automatically, since OPNsense ignores them
+// otherwise.
+func validateKeaDhcpv4SubnetConfig(d *KeaDhcpv4SubnetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Auto collect is enabled by default (defaults only apply to the plan)
+	if d.AutoCollect.IsUnknown() || (!d.AutoCollect.IsNull() && !d.AutoCollect.ValueBool()) {
+		return diags
+	}
+
+	isSet := func(l types.List) bool {
+		return !l.IsNull() && !l.IsUnknown() && len(l.Elements()) > 0
+	}
+
+	if isSet(d.Routers) {
+		diags.AddAttributeError(path.Root("routers"), "Invalid Attribute Combination",
+			"Attribute \"routers\" can only be set when auto_collect is false.")
+	}
+	if isSet(d.DNSServers) {
+		diags.AddAttributeError(path.Root("dns_servers"), "Invalid Attribute Combination",
+			"Attribute \"dns_servers\" can only be set when auto_collect is false.")
+	}
+
+	return diags
+}
+
+func convertKeaDhcpv4SubnetSchemaToStruct(d *KeaDhcpv4SubnetResourceModel) (*kea.Dhcpv4Subnet, error) {
+	// Parse 'Pools'
+	var poolsList []string
+	d.Pools.ElementsAs(context.Background(), &poolsList, false)
+
+	// Parse 'Routers'
+	var routersList []string
+	d.Routers.ElementsAs(context.Background(), &routersList, false)
+
+	// Parse 'DNSServers'
+	var dnsServersList []string
+	d.DNSServers.ElementsAs(context.Background(), &dnsServersList, false)
+
+	// Parse 'DomainSearch'
+	var domainSearchList []string
+	d.DomainSearch.ElementsAs(context.Background(), &domainSearchList, false)
+
+	// Parse 'NTPServers'
+	var ntpServersList []string
+	d.NTPServers.ElementsAs(context.Background(), &ntpServersList, false)
+
+	return &kea.Dhcpv4Subnet{
+		Subnet:                d.Subnet.ValueString(),
+		NextServer:            d.NextServer.ValueString(),
+		OptionDataAutocollect: tools.BoolToString(d.AutoCollect.ValueBool()),
+		OptionData: kea.Dhcpv4OptionData{
+			DomainNameServers: dnsServersList,
+			DomainSearch:      domainSearchList,
+			Routers:           routersList,
+			DomainName:        d.DomainName.ValueString(),
+			NTPServers:        ntpServersList,
+		},
+		Pools:       strings.Join(poolsList, "\n"),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertKeaDhcpv4SubnetStructToSchema(d *kea.Dhcpv4Subnet) (*KeaDhcpv4SubnetResourceModel, error) {
+	return &KeaDhcpv4SubnetResourceModel{
+		Subnet:       types.StringValue(d.Subnet),
+		Pools:        tools.StringSliceToList(strings.Split(d.Pools, "\n")),
+		NextServer:   types.StringValue(d.NextServer),
+		Description:  tools.StringOrNull(d.Description),
+		AutoCollect:  types.BoolValue(tools.StringToBool(d.OptionDataAutocollect)),
+		Routers:      tools.StringSliceToList(d.OptionData.Routers),
+		DNSServers:   tools.StringSliceToList(d.OptionData.DomainNameServers),
+		DomainName:   types.StringValue(d.OptionData.DomainName),
+		DomainSearch: tools.StringSliceToList(d.OptionData.DomainSearch),
+		NTPServers:   tools.StringSliceToList(d.OptionData.NTPServers),
+	}, nil
+}
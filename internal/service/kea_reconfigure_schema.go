@@ -0,0 +1,40 @@
+package service
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// KeaReconfigureResourceModel describes the resource data model.
+type KeaReconfigureResourceModel struct {
+	Triggers types.Map `tfsdk:"triggers"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func keaReconfigureResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Applies pending Kea DHCP configuration changes. The Kea resources only stage their changes, this resource reconfigures the service once per apply, whenever any of its `triggers` change.",
+
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will cause Kea to be reconfigured. Typically a hash of the Kea resources managed in the same configuration, so that in-place updates are applied as well.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the reconfiguration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
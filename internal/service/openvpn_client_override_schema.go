@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/openvpn"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// OpenVPNClientOverrideResourceModel describes the resource data model.
+type OpenVPNClientOverrideResourceModel struct {
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Servers           types.Set    `tfsdk:"servers"`
+	CommonName        types.String `tfsdk:"common_name"`
+	Block             types.Bool   `tfsdk:"block"`
+	PushReset         types.Bool   `tfsdk:"push_reset"`
+	TunnelNetwork     types.String `tfsdk:"tunnel_network"`
+	TunnelNetworkIPv6 types.String `tfsdk:"tunnel_network_ipv6"`
+	LocalNetworks     types.Set    `tfsdk:"local_networks"`
+	RemoteNetworks    types.Set    `tfsdk:"remote_networks"`
+	RedirectGateway   types.Set    `tfsdk:"redirect_gateway"`
+	DNSServers        types.List   `tfsdk:"dns_servers"`
+	DNSDomain         types.String `tfsdk:"dns_domain"`
+	Description       types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func openVPNClientOverrideResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Client specific overrides change the settings an OpenVPN server pushes to a single client, identified by the common name of its certificate (or its username, see `username_as_common_name` of `opnsense_openvpn_instance`).",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this override. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"servers": schema.SetAttribute{
+				MarkdownDescription: "UUIDs of the server instances (see `opnsense_openvpn_instance`) this override applies to. Applies to all servers when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"common_name": schema.StringAttribute{
+				MarkdownDescription: "Common name of the client to override settings for.",
+				Required:            true,
+			},
+			"block": schema.BoolAttribute{
+				MarkdownDescription: "Block the client from connecting. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"push_reset": schema.BoolAttribute{
+				MarkdownDescription: "Do not push the options of the server to the client, only those of this override. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tunnel_network": schema.StringAttribute{
+				MarkdownDescription: "IPv4 address of the client inside the tunnel, in CIDR notation, e.g. `10.8.0.10/24`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsCIDR(),
+				},
+			},
+			"tunnel_network_ipv6": schema.StringAttribute{
+				MarkdownDescription: "IPv6 address of the client inside the tunnel, in CIDR notation.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsCIDR(),
+				},
+			},
+			"local_networks": schema.SetAttribute{
+				MarkdownDescription: "Local networks, in CIDR notation, pushed to the client as routes. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"remote_networks": schema.SetAttribute{
+				MarkdownDescription: "Networks, in CIDR notation, behind the client that are routed to it. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"redirect_gateway": schema.SetAttribute{
+				MarkdownDescription: "Flags pushed to the client to redirect its default gateway through the tunnel. Available values: `def1`, `ipv6`, `local`, `autolocal`, `bypass_dhcp`, `bypass_dns`, `block_local`, `block_ipv6`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(openvpnRedirectGatewayOptions...)),
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "DNS servers pushed to the client, in order of preference. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IsIP()),
+				},
+			},
+			"dns_domain": schema.StringAttribute{
+				MarkdownDescription: "DNS domain pushed to the client.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the client override.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func OpenVPNClientOverrideDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Client specific overrides change the settings an OpenVPN server pushes to a single client.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this override is enabled.",
+				Computed:            true,
+			},
+			"servers": dschema.SetAttribute{
+				MarkdownDescription: "UUIDs of the server instances this override applies to.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"common_name": dschema.StringAttribute{
+				MarkdownDescription: "Common name of the client.",
+				Computed:            true,
+			},
+			"block": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the client is blocked from connecting.",
+				Computed:            true,
+			},
+			"push_reset": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the options of the server are not pushed to the client.",
+				Computed:            true,
+			},
+			"tunnel_network": dschema.StringAttribute{
+				MarkdownDescription: "IPv4 address of the client inside the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_network_ipv6": dschema.StringAttribute{
+				MarkdownDescription: "IPv6 address of the client inside the tunnel.",
+				Computed:            true,
+			},
+			"local_networks": dschema.SetAttribute{
+				MarkdownDescription: "Local networks pushed to the client as routes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"remote_networks": dschema.SetAttribute{
+				MarkdownDescription: "Networks behind the client that are routed to it.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redirect_gateway": dschema.SetAttribute{
+				MarkdownDescription: "Flags pushed to the client to redirect its default gateway.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dns_servers": dschema.ListAttribute{
+				MarkdownDescription: "DNS servers pushed to the client.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dns_domain": dschema.StringAttribute{
+				MarkdownDescription: "DNS domain pushed to the client.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertOpenVPNClientOverrideSchemaToStruct(d *OpenVPNClientOverrideResourceModel) (*openvpn.ClientOverride, error) {
+	var serverList, localNetworkList, remoteNetworkList, redirectGatewayList, dnsServerList []string
+
+	ctx := context.Background()
+	d.Servers.ElementsAs(ctx, &serverList, false)
+	d.LocalNetworks.ElementsAs(ctx, &localNetworkList, false)
+	d.RemoteNetworks.ElementsAs(ctx, &remoteNetworkList, false)
+	d.RedirectGateway.ElementsAs(ctx, &redirectGatewayList, false)
+	d.DNSServers.ElementsAs(ctx, &dnsServerList, false)
+
+	return &openvpn.ClientOverride{
+		Enabled:           tools.BoolToString(d.Enabled.ValueBool()),
+		Servers:           serverList,
+		CommonName:        d.CommonName.ValueString(),
+		Block:             tools.BoolToString(d.Block.ValueBool()),
+		PushReset:         tools.BoolToString(d.PushReset.ValueBool()),
+		TunnelNetwork:     d.TunnelNetwork.ValueString(),
+		TunnelNetworkIPv6: d.TunnelNetworkIPv6.ValueString(),
+		LocalNetworks:     localNetworkList,
+		RemoteNetworks:    remoteNetworkList,
+		RedirectGateway:   redirectGatewayList,
+		DNSServers:        dnsServerList,
+		DNSDomain:         d.DNSDomain.ValueString(),
+		Description:       d.Description.ValueString(),
+	}, nil
+}
+
+func convertOpenVPNClientOverrideStructToSchema(d *openvpn.ClientOverride) (*OpenVPNClientOverrideResourceModel, error) {
+	return &OpenVPNClientOverrideResourceModel{
+		Enabled:           types.BoolValue(tools.StringToBool(d.Enabled)),
+		Servers:           tools.StringSliceToSet(d.Servers),
+		CommonName:        types.StringValue(d.CommonName),
+		Block:             types.BoolValue(tools.StringToBool(d.Block)),
+		PushReset:         types.BoolValue(tools.StringToBool(d.PushReset)),
+		TunnelNetwork:     tools.StringOrNull(d.TunnelNetwork),
+		TunnelNetworkIPv6: tools.StringOrNull(d.TunnelNetworkIPv6),
+		LocalNetworks:     tools.StringSliceToSet(d.LocalNetworks),
+		RemoteNetworks:    tools.StringSliceToSet(d.RemoteNetworks),
+		RedirectGateway:   tools.StringSliceToSet(d.RedirectGateway),
+		DNSServers:        tools.StringSliceToList(d.DNSServers),
+		DNSDomain:         tools.StringOrNull(d.DNSDomain),
+		Description:       tools.StringOrNull(d.Description),
+	}, nil
+}
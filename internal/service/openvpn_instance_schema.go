@@ -0,0 +1,518 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/openvpn"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// openvpnRedirectGatewayOptions are the flags accepted by redirect_gateway.
+var openvpnRedirectGatewayOptions = []string{
+	"def1", "ipv6", "local", "autolocal", "bypass_dhcp", "bypass_dns", "block_local", "block_ipv6",
+}
+
+// OpenVPNInstanceResourceModel describes the resource data model.
+type OpenVPNInstanceResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Description types.String `tfsdk:"description"`
+	Role        types.String `tfsdk:"role"`
+	DevType     types.String `tfsdk:"dev_type"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Port        types.Int64  `tfsdk:"port"`
+	Local       types.String `tfsdk:"local"`
+	Topology    types.String `tfsdk:"topology"`
+	Remote      types.List   `tfsdk:"remote"`
+
+	TunnelNetwork     types.String `tfsdk:"tunnel_network"`
+	TunnelNetworkIPv6 types.String `tfsdk:"tunnel_network_ipv6"`
+
+	Cert             types.String `tfsdk:"cert"`
+	CA               types.String `tfsdk:"ca"`
+	VerifyClientCert types.String `tfsdk:"verify_client_cert"`
+	Auth             types.String `tfsdk:"auth"`
+	DataCiphers      types.Set    `tfsdk:"data_ciphers"`
+	TLSKey           types.String `tfsdk:"tls_key"`
+
+	AuthServers          types.Set    `tfsdk:"auth_servers"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	UsernameAsCommonName types.Bool   `tfsdk:"username_as_common_name"`
+
+	Routes          types.Set    `tfsdk:"routes"`
+	PushRoutes      types.Set    `tfsdk:"push_routes"`
+	RedirectGateway types.Set    `tfsdk:"redirect_gateway"`
+	DNSServers      types.List   `tfsdk:"dns_servers"`
+	DNSDomain       types.String `tfsdk:"dns_domain"`
+
+	KeepaliveInterval types.Int64 `tfsdk:"keepalive_interval"`
+	KeepaliveTimeout  types.Int64 `tfsdk:"keepalive_timeout"`
+	MaxClients        types.Int64 `tfsdk:"max_clients"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func openVPNInstanceResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "OpenVPN instances are servers or clients managed through the OpenVPN MVC API (OPNsense 23.7 or later). Certificates are referenced by their trust store reference ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this instance. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the instance. Available values: `server`, `client`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("server", "client"),
+				},
+			},
+			"dev_type": schema.StringAttribute{
+				MarkdownDescription: "Type of tunnel device. Available values: `tun`, `tap`, `ovpn`. Defaults to `tun`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("tun"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("tun", "tap", "ovpn"),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol to use. Available values: `udp`, `udp4`, `udp6`, `tcp`, `tcp4`, `tcp6`. Defaults to `udp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("udp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("udp", "udp4", "udp6", "tcp", "tcp4", "tcp6"),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port to listen on (servers) or bind to (clients). Set to `-1` to use the default of 1194 for servers, or a random port for clients. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"local": schema.StringAttribute{
+				MarkdownDescription: "IP address to bind to. Binds to all addresses when not set.",
+				Optional:            true,
+			},
+			"topology": schema.StringAttribute{
+				MarkdownDescription: "Topology of the tunnel. Available values: `net30`, `p2p`, `subnet`. Defaults to `subnet`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("subnet"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("net30", "p2p", "subnet"),
+				},
+			},
+			"remote": schema.ListAttribute{
+				MarkdownDescription: "Remote hosts to connect to, optionally followed by a colon and port, e.g. `vpn.example.com:1194`. Must be set when `role` is `client`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+			},
+			"tunnel_network": schema.StringAttribute{
+				MarkdownDescription: "IPv4 network of the tunnel, in CIDR notation, e.g. `10.8.0.0/24`. Servers hand out addresses from this network to clients.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsCIDR(),
+				},
+			},
+			"tunnel_network_ipv6": schema.StringAttribute{
+				MarkdownDescription: "IPv6 network of the tunnel, in CIDR notation, e.g. `fd00:8::/64`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsCIDR(),
+				},
+			},
+			"cert": schema.StringAttribute{
+				MarkdownDescription: "Reference ID of the certificate of this instance in the trust store, see `opnsense_trust_cert`. Must be set when `role` is `server`.",
+				Optional:            true,
+			},
+			"ca": schema.StringAttribute{
+				MarkdownDescription: "Reference ID of the certificate authority used to verify the remote side, see `opnsense_trust_ca`.",
+				Optional:            true,
+			},
+			"verify_client_cert": schema.StringAttribute{
+				MarkdownDescription: "Whether servers require clients to present a certificate. Available values: `none`, `require`. Defaults to `require`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("require"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "require"),
+				},
+			},
+			"auth": schema.StringAttribute{
+				MarkdownDescription: "Message digest used to authenticate packets, e.g. `SHA256`. Uses the OpenVPN default when not set.",
+				Optional:            true,
+			},
+			"data_ciphers": schema.SetAttribute{
+				MarkdownDescription: "Ciphers allowed to encrypt the data channel, e.g. `AES-256-GCM`. Uses the OpenVPN defaults when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"tls_key": schema.StringAttribute{
+				MarkdownDescription: "UUID of the static key (see `opnsense_openvpn_static_key`) used for TLS authentication or encryption of the control channel.",
+				Optional:            true,
+			},
+			"auth_servers": schema.SetAttribute{
+				MarkdownDescription: "Names of the authentication servers to verify usernames and passwords against, e.g. `Local Database`. Only used when `role` is `server`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username to authenticate with. Only used when `role` is `client`.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password to authenticate with. Only used when `role` is `client`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"username_as_common_name": schema.BoolAttribute{
+				MarkdownDescription: "Use the authenticated username as the common name, e.g. to match client specific overrides. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"routes": schema.SetAttribute{
+				MarkdownDescription: "Remote networks, in CIDR notation, routed through the tunnel. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"push_routes": schema.SetAttribute{
+				MarkdownDescription: "Local networks, in CIDR notation, pushed to clients as routes. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"redirect_gateway": schema.SetAttribute{
+				MarkdownDescription: "Flags pushed to clients to redirect their default gateway through the tunnel. Available values: `def1`, `ipv6`, `local`, `autolocal`, `bypass_dhcp`, `bypass_dns`, `block_local`, `block_ipv6`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(openvpnRedirectGatewayOptions...)),
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "DNS servers pushed to clients, in order of preference. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IsIP()),
+				},
+			},
+			"dns_domain": schema.StringAttribute{
+				MarkdownDescription: "DNS domain pushed to clients.",
+				Optional:            true,
+			},
+			"keepalive_interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval, in seconds, to ping the remote side at. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"keepalive_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, without a ping after which the remote side is considered down. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"max_clients": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrently connected clients. Set to `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func OpenVPNInstanceDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "OpenVPN instances are servers or clients managed through the OpenVPN MVC API (OPNsense 23.7 or later).",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this instance is enabled.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+			"role": dschema.StringAttribute{
+				MarkdownDescription: "Role of the instance.",
+				Computed:            true,
+			},
+			"dev_type": dschema.StringAttribute{
+				MarkdownDescription: "Type of tunnel device.",
+				Computed:            true,
+			},
+			"protocol": dschema.StringAttribute{
+				MarkdownDescription: "Protocol used.",
+				Computed:            true,
+			},
+			"port": dschema.Int64Attribute{
+				MarkdownDescription: "Port the instance listens on or binds to. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"local": dschema.StringAttribute{
+				MarkdownDescription: "IP address bound to.",
+				Computed:            true,
+			},
+			"topology": dschema.StringAttribute{
+				MarkdownDescription: "Topology of the tunnel.",
+				Computed:            true,
+			},
+			"remote": dschema.ListAttribute{
+				MarkdownDescription: "Remote hosts to connect to.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tunnel_network": dschema.StringAttribute{
+				MarkdownDescription: "IPv4 network of the tunnel.",
+				Computed:            true,
+			},
+			"tunnel_network_ipv6": dschema.StringAttribute{
+				MarkdownDescription: "IPv6 network of the tunnel.",
+				Computed:            true,
+			},
+			"cert": dschema.StringAttribute{
+				MarkdownDescription: "Reference ID of the certificate of this instance.",
+				Computed:            true,
+			},
+			"ca": dschema.StringAttribute{
+				MarkdownDescription: "Reference ID of the certificate authority used to verify the remote side.",
+				Computed:            true,
+			},
+			"verify_client_cert": dschema.StringAttribute{
+				MarkdownDescription: "Whether servers require clients to present a certificate.",
+				Computed:            true,
+			},
+			"auth": dschema.StringAttribute{
+				MarkdownDescription: "Message digest used to authenticate packets.",
+				Computed:            true,
+			},
+			"data_ciphers": dschema.SetAttribute{
+				MarkdownDescription: "Ciphers allowed to encrypt the data channel.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tls_key": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the static key of the control channel.",
+				Computed:            true,
+			},
+			"auth_servers": dschema.SetAttribute{
+				MarkdownDescription: "Names of the authentication servers.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"username": dschema.StringAttribute{
+				MarkdownDescription: "Username to authenticate with.",
+				Computed:            true,
+			},
+			"password": dschema.StringAttribute{
+				MarkdownDescription: "Password to authenticate with.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"username_as_common_name": dschema.BoolAttribute{
+				MarkdownDescription: "Whether the authenticated username is used as the common name.",
+				Computed:            true,
+			},
+			"routes": dschema.SetAttribute{
+				MarkdownDescription: "Remote networks routed through the tunnel.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"push_routes": dschema.SetAttribute{
+				MarkdownDescription: "Local networks pushed to clients as routes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"redirect_gateway": dschema.SetAttribute{
+				MarkdownDescription: "Flags pushed to clients to redirect their default gateway.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dns_servers": dschema.ListAttribute{
+				MarkdownDescription: "DNS servers pushed to clients.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dns_domain": dschema.StringAttribute{
+				MarkdownDescription: "DNS domain pushed to clients.",
+				Computed:            true,
+			},
+			"keepalive_interval": dschema.Int64Attribute{
+				MarkdownDescription: "Interval, in seconds, to ping the remote side at. `-1` if disabled.",
+				Computed:            true,
+			},
+			"keepalive_timeout": dschema.Int64Attribute{
+				MarkdownDescription: "Time, in seconds, without a ping after which the remote side is considered down. `-1` if disabled.",
+				Computed:            true,
+			},
+			"max_clients": dschema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrently connected clients. `-1` if there is no limit.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// validateOpenVPNInstanceConfig checks the attributes required by each role.
+func validateOpenVPNInstanceConfig(d *OpenVPNInstanceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Role.IsUnknown() {
+		return diags
+	}
+
+	switch d.Role.ValueString() {
+	case "server":
+		if d.Cert.IsNull() {
+			diags.AddAttributeError(path.Root("cert"), "Missing Attribute Configuration",
+				"Attribute \"cert\" must be set when role is server.")
+		}
+	case "client":
+		if d.Remote.IsNull() || (!d.Remote.IsUnknown() && len(d.Remote.Elements()) == 0) {
+			diags.AddAttributeError(path.Root("remote"), "Missing Attribute Configuration",
+				"Attribute \"remote\" must be set when role is client.")
+		}
+	}
+
+	return diags
+}
+
+func convertOpenVPNInstanceSchemaToStruct(d *OpenVPNInstanceResourceModel) (*openvpn.Instance, error) {
+	var remoteList, dnsServerList []string
+	var dataCipherList, authServerList, routeList, pushRouteList, redirectGatewayList []string
+
+	ctx := context.Background()
+	d.Remote.ElementsAs(ctx, &remoteList, false)
+	d.DNSServers.ElementsAs(ctx, &dnsServerList, false)
+	d.DataCiphers.ElementsAs(ctx, &dataCipherList, false)
+	d.AuthServers.ElementsAs(ctx, &authServerList, false)
+	d.Routes.ElementsAs(ctx, &routeList, false)
+	d.PushRoutes.ElementsAs(ctx, &pushRouteList, false)
+	d.RedirectGateway.ElementsAs(ctx, &redirectGatewayList, false)
+
+	return &openvpn.Instance{
+		Enabled:              tools.BoolToString(d.Enabled.ValueBool()),
+		Description:          d.Description.ValueString(),
+		Role:                 api.SelectedMap(d.Role.ValueString()),
+		DevType:              api.SelectedMap(d.DevType.ValueString()),
+		Proto:                api.SelectedMap(d.Protocol.ValueString()),
+		Port:                 tools.Int64ToStringNegative(d.Port.ValueInt64()),
+		Local:                d.Local.ValueString(),
+		Topology:             api.SelectedMap(d.Topology.ValueString()),
+		Remote:               remoteList,
+		Server:               d.TunnelNetwork.ValueString(),
+		ServerIPv6:           d.TunnelNetworkIPv6.ValueString(),
+		Cert:                 api.SelectedMap(d.Cert.ValueString()),
+		CA:                   api.SelectedMap(d.CA.ValueString()),
+		VerifyClientCert:     api.SelectedMap(d.VerifyClientCert.ValueString()),
+		Auth:                 api.SelectedMap(d.Auth.ValueString()),
+		DataCiphers:          dataCipherList,
+		TLSKey:               api.SelectedMap(d.TLSKey.ValueString()),
+		AuthMode:             authServerList,
+		Username:             d.Username.ValueString(),
+		Password:             d.Password.ValueString(),
+		UsernameAsCommonName: tools.BoolToString(d.UsernameAsCommonName.ValueBool()),
+		Route:                routeList,
+		PushRoute:            pushRouteList,
+		RedirectGateway:      redirectGatewayList,
+		DNSServers:           dnsServerList,
+		DNSDomain:            d.DNSDomain.ValueString(),
+		KeepaliveInterval:    tools.Int64ToStringNegative(d.KeepaliveInterval.ValueInt64()),
+		KeepaliveTimeout:     tools.Int64ToStringNegative(d.KeepaliveTimeout.ValueInt64()),
+		MaxClients:           tools.Int64ToStringNegative(d.MaxClients.ValueInt64()),
+	}, nil
+}
+
+func convertOpenVPNInstanceStructToSchema(d *openvpn.Instance) (*OpenVPNInstanceResourceModel, error) {
+	return &OpenVPNInstanceResourceModel{
+		Enabled:              types.BoolValue(tools.StringToBool(d.Enabled)),
+		Description:          tools.StringOrNull(d.Description),
+		Role:                 types.StringValue(d.Role.String()),
+		DevType:              types.StringValue(d.DevType.String()),
+		Protocol:             types.StringValue(d.Proto.String()),
+		Port:                 types.Int64Value(tools.StringToInt64(d.Port)),
+		Local:                tools.StringOrNull(d.Local),
+		Topology:             types.StringValue(d.Topology.String()),
+		Remote:               tools.StringSliceToList(d.Remote),
+		TunnelNetwork:        tools.StringOrNull(d.Server),
+		TunnelNetworkIPv6:    tools.StringOrNull(d.ServerIPv6),
+		Cert:                 tools.StringOrNull(d.Cert.String()),
+		CA:                   tools.StringOrNull(d.CA.String()),
+		VerifyClientCert:     types.StringValue(d.VerifyClientCert.String()),
+		Auth:                 tools.StringOrNull(d.Auth.String()),
+		DataCiphers:          tools.StringSliceToSet(d.DataCiphers),
+		TLSKey:               tools.StringOrNull(d.TLSKey.String()),
+		AuthServers:          tools.StringSliceToSet(d.AuthMode),
+		Username:             tools.StringOrNull(d.Username),
+		Password:             tools.StringOrNull(d.Password),
+		UsernameAsCommonName: types.BoolValue(tools.StringToBool(d.UsernameAsCommonName)),
+		Routes:               tools.StringSliceToSet(d.Route),
+		PushRoutes:           tools.StringSliceToSet(d.PushRoute),
+		RedirectGateway:      tools.StringSliceToSet(d.RedirectGateway),
+		DNSServers:           tools.StringSliceToList(d.DNSServers),
+		DNSDomain:            tools.StringOrNull(d.DNSDomain),
+		KeepaliveInterval:    types.Int64Value(tools.StringToInt64(d.KeepaliveInterval)),
+		KeepaliveTimeout:     types.Int64Value(tools.StringToInt64(d.KeepaliveTimeout)),
+		MaxClients:           types.Int64Value(tools.StringToInt64(d.MaxClients)),
+	}, nil
+}
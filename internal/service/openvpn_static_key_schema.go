@@ -0,0 +1,104 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/openvpn"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// OpenVPNStaticKeyResourceModel describes the resource data model.
+type OpenVPNStaticKeyResourceModel struct {
+	Mode        types.String `tfsdk:"mode"`
+	Key         types.String `tfsdk:"key"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func openVPNStaticKeyResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Static keys add authentication (`tls-auth`) or encryption (`tls-crypt`) to the control channel of OpenVPN instances. When no `key` is set, one is generated by OPNsense.",
+
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "How the key is used. Available values: `auth`, `crypt`. Defaults to `crypt`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("crypt"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("auth", "crypt"),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "OpenVPN static key, starting with `-----BEGIN OpenVPN Static key V1-----`. Generated by OPNsense when not set.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the static key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func OpenVPNStaticKeyDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Static keys add authentication (`tls-auth`) or encryption (`tls-crypt`) to the control channel of OpenVPN instances.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"mode": dschema.StringAttribute{
+				MarkdownDescription: "How the key is used.",
+				Computed:            true,
+			},
+			"key": dschema.StringAttribute{
+				MarkdownDescription: "OpenVPN static key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertOpenVPNStaticKeySchemaToStruct(d *OpenVPNStaticKeyResourceModel) (*openvpn.StaticKey, error) {
+	return &openvpn.StaticKey{
+		Mode:        api.SelectedMap(d.Mode.ValueString()),
+		Key:         d.Key.ValueString(),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertOpenVPNStaticKeyStructToSchema(d *openvpn.StaticKey) (*OpenVPNStaticKeyResourceModel, error) {
+	return &OpenVPNStaticKeyResourceModel{
+		Mode:        types.StringValue(d.Mode.String()),
+		Key:         types.StringValue(d.Key),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
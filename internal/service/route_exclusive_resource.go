@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+func NewRouteExclusiveResource() resource.Resource {
+	return &ExclusiveResource{kind: &exclusiveKind{
+		typeName: "route",
+		noun:     "route",
+		plural:   "routes",
+		list: func(ctx context.Context, c opnsense.Client) ([]exclusiveObject, error) {
+			rows, err := c.Routes().SearchRoutes(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var objects []exclusiveObject
+			for _, row := range rows {
+				objects = append(objects, exclusiveObject{
+					Id:   row.Id,
+					Name: fmt.Sprintf("%s via %s", row.Network, row.Gateway),
+				})
+			}
+			return objects, nil
+		},
+		delete: func(ctx context.Context, c opnsense.Client, id string) error {
+			return c.Routes().DeleteRoute(ctx, id)
+		},
+	}}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/syslog"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+var syslogTransports = []string{"udp4", "tcp4", "udp6", "tcp6", "tls4", "tls6"}
+
+var syslogLevels = []string{"debug", "info", "notice", "warn", "err", "crit", "alert", "emerg"}
+
+var syslogFacilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news", "uucp", "cron", "authpriv", "ftp", "ntp",
+	"security", "console", "local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+// SyslogDestinationResourceModel describes the resource data model.
+type SyslogDestinationResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Transport   types.String `tfsdk:"transport"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Port        types.Int64  `tfsdk:"port"`
+	Programs    types.Set    `tfsdk:"programs"`
+	Levels      types.Set    `tfsdk:"levels"`
+	Facilities  types.Set    `tfsdk:"facilities"`
+	Certificate types.String `tfsdk:"certificate"`
+	RFC5424     types.Bool   `tfsdk:"rfc5424"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func syslogDestinationResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Syslog destinations forward local log messages to a remote syslog server, e.g. a SIEM.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this destination. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"transport": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Transport protocol. Available values: `%s`. Defaults to `udp4`.", strings.Join(syslogTransports, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("udp4"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(syslogTransports...),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname or IP address of the remote syslog server.",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the remote syslog server. Defaults to `514`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(514),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"programs": schema.SetAttribute{
+				MarkdownDescription: "Only forward messages of these applications, e.g. `filterlog` for the firewall log. Forwards messages of all applications when empty. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"levels": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("Only forward messages of these severity levels. Forwards messages of all levels when empty. Available values: `%s`. Defaults to `[]`.", strings.Join(syslogLevels, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(syslogLevels...)),
+				},
+			},
+			"facilities": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("Only forward messages of these facilities. Forwards messages of all facilities when empty. Available values: `%s`. Defaults to `[]`.", strings.Join(syslogFacilities, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(syslogFacilities...)),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "Reference ID of the client certificate (see `opnsense_trust_cert`) presented to the remote server. Only applies when `transport` is `tls4` or `tls6`.",
+				Optional:            true,
+			},
+			"rfc5424": schema.BoolAttribute{
+				MarkdownDescription: "Send messages in RFC5424 format, instead of the legacy BSD (RFC3164) format. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the destination.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func syslogDestinationDataSourceAttributes() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"enabled": dschema.BoolAttribute{
+			MarkdownDescription: "Whether this destination is enabled.",
+			Computed:            true,
+		},
+		"transport": dschema.StringAttribute{
+			MarkdownDescription: "Transport protocol.",
+			Computed:            true,
+		},
+		"hostname": dschema.StringAttribute{
+			MarkdownDescription: "Hostname or IP address of the remote syslog server.",
+			Computed:            true,
+		},
+		"port": dschema.Int64Attribute{
+			MarkdownDescription: "Port of the remote syslog server.",
+			Computed:            true,
+		},
+		"programs": dschema.SetAttribute{
+			MarkdownDescription: "Applications whose messages are forwarded. All applications when empty.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"levels": dschema.SetAttribute{
+			MarkdownDescription: "Severity levels that are forwarded. All levels when empty.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"facilities": dschema.SetAttribute{
+			MarkdownDescription: "Facilities that are forwarded. All facilities when empty.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"certificate": dschema.StringAttribute{
+			MarkdownDescription: "Reference ID of the client certificate presented to the remote server.",
+			Computed:            true,
+		},
+		"rfc5424": dschema.BoolAttribute{
+			MarkdownDescription: "Whether messages are sent in RFC5424 format.",
+			Computed:            true,
+		},
+		"description": dschema.StringAttribute{
+			MarkdownDescription: "Optional description here for your reference (not parsed).",
+			Computed:            true,
+		},
+	}
+}
+
+func SyslogDestinationDataSourceSchema() dschema.Schema {
+	attributes := syslogDestinationDataSourceAttributes()
+	attributes["id"] = dschema.StringAttribute{
+		MarkdownDescription: "UUID of the resource.",
+		Required:            true,
+	}
+
+	return dschema.Schema{
+		MarkdownDescription: "Syslog destinations forward local log messages to a remote syslog server, e.g. a SIEM.",
+		Attributes:          attributes,
+	}
+}
+
+func validateSyslogDestinationConfig(d *SyslogDestinationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if !d.Certificate.IsNull() && !d.Transport.IsUnknown() && !strings.HasPrefix(d.Transport.ValueString(), "tls") {
+		diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Invalid Attribute Combination",
+			"Attribute certificate can only be configured when transport is tls4 or tls6.",
+		)
+	}
+
+	return diagnostics
+}
+
+func convertSyslogDestinationSchemaToStruct(d *SyslogDestinationResourceModel) (*syslog.Destination, error) {
+	var programList, levelList, facilityList []string
+
+	ctx := context.Background()
+	d.Programs.ElementsAs(ctx, &programList, false)
+	d.Levels.ElementsAs(ctx, &levelList, false)
+	d.Facilities.ElementsAs(ctx, &facilityList, false)
+
+	return &syslog.Destination{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Transport:   api.SelectedMap(d.Transport.ValueString()),
+		Program:     programList,
+		Level:       levelList,
+		Facility:    facilityList,
+		Hostname:    d.Hostname.ValueString(),
+		Certificate: api.SelectedMap(d.Certificate.ValueString()),
+		Port:        tools.Int64ToString(d.Port.ValueInt64()),
+		RFC5424:     tools.BoolToString(d.RFC5424.ValueBool()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertSyslogDestinationStructToSchema(d *syslog.Destination) (*SyslogDestinationResourceModel, error) {
+	return &SyslogDestinationResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Transport:   types.StringValue(d.Transport.String()),
+		Hostname:    types.StringValue(d.Hostname),
+		Port:        types.Int64Value(tools.StringToInt64(d.Port)),
+		Programs:    tools.StringSliceToSet(d.Program),
+		Levels:      tools.StringSliceToSet(d.Level),
+		Facilities:  tools.StringSliceToSet(d.Facility),
+		Certificate: tools.StringOrNull(d.Certificate.String()),
+		RFC5424:     types.BoolValue(tools.StringToBool(d.RFC5424)),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/syslog"
+)
+
+// SyslogDestinationsDataSourceModel describes the data source data model.
+type SyslogDestinationsDataSourceModel struct {
+	Destinations []SyslogDestinationResourceModel `tfsdk:"destinations"`
+}
+
+func SyslogDestinationsDataSourceSchema() dschema.Schema {
+	attributes := syslogDestinationDataSourceAttributes()
+	attributes["id"] = dschema.StringAttribute{
+		MarkdownDescription: "UUID of the destination.",
+		Computed:            true,
+	}
+
+	return dschema.Schema{
+		MarkdownDescription: "Lists the remote syslog destinations configured on the OPNsense host, including those not managed by Terraform.",
+
+		Attributes: map[string]dschema.Attribute{
+			"destinations": dschema.ListNestedAttribute{
+				MarkdownDescription: "List of destinations, sorted by `hostname` and `port`.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+func convertSyslogDestinationsStructToSchema(destinations map[string]syslog.Destination) (*SyslogDestinationsDataSourceModel, error) {
+	model := &SyslogDestinationsDataSourceModel{
+		Destinations: []SyslogDestinationResourceModel{},
+	}
+
+	for id, destination := range destinations {
+		destinationModel, err := convertSyslogDestinationStructToSchema(&destination)
+		if err != nil {
+			return nil, err
+		}
+		destinationModel.Id = types.StringValue(id)
+
+		model.Destinations = append(model.Destinations, *destinationModel)
+	}
+
+	sort.Slice(model.Destinations, func(i, j int) bool {
+		a, b := model.Destinations[i], model.Destinations[j]
+		if a.Hostname.ValueString() != b.Hostname.ValueString() {
+			return a.Hostname.ValueString() < b.Hostname.ValueString()
+		}
+		if a.Port.ValueInt64() != b.Port.ValueInt64() {
+			return a.Port.ValueInt64() < b.Port.ValueInt64()
+		}
+		return a.Id.ValueString() < b.Id.ValueString()
+	})
+
+	return model, nil
+}
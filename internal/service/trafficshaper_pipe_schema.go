@@ -0,0 +1,237 @@
+package service
+
+import (
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/trafficshaper"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// trafficShaperBandwidthUnits maps the bandwidth units to their value in bit/s.
+var trafficShaperBandwidthUnits = map[string]int64{
+	"bit":  1,
+	"Kbit": 1000,
+	"Mbit": 1000 * 1000,
+	"Gbit": 1000 * 1000 * 1000,
+}
+
+// trafficShaperMaxBandwidth is the highest bandwidth (in bit/s) dummynet can
+// shape to, as it is stored in an unsigned 32-bit integer.
+const trafficShaperMaxBandwidth = 1<<32 - 1
+
+// trafficShaperSchedulers maps the schedulers to the values used by OPNsense.
+var trafficShaperSchedulers = map[string]string{
+	"wfq":      "",
+	"fifo":     "fifo",
+	"rr":       "rr",
+	"qfq":      "qfq",
+	"fq_codel": "fq_codel",
+	"fq_pie":   "fq_pie",
+}
+
+// TrafficShaperPipeResourceModel describes the resource data model.
+type TrafficShaperPipeResourceModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Bandwidth     types.Int64  `tfsdk:"bandwidth"`
+	BandwidthUnit types.String `tfsdk:"bandwidth_unit"`
+	Queue         types.Int64  `tfsdk:"queue"`
+	Mask          types.String `tfsdk:"mask"`
+	Scheduler     types.String `tfsdk:"scheduler"`
+	Delay         types.Int64  `tfsdk:"delay"`
+	Description   types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func trafficShaperPipeResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Traffic shaper pipes limit the bandwidth of the traffic sent through them. Traffic is sent to a pipe directly, or through one of its queues, by a traffic shaper rule.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this pipe. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"bandwidth": schema.Int64Attribute{
+				MarkdownDescription: "Bandwidth of the pipe, in `bandwidth_unit`. Together with the unit, must not exceed 4294967295 bit/s (about 4.29 Gbit/s).",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"bandwidth_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of `bandwidth`. Available values: `bit`, `Kbit`, `Mbit`, `Gbit`. Defaults to `Mbit`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Mbit"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("bit", "Kbit", "Mbit", "Gbit"),
+				},
+			},
+			"queue": schema.Int64Attribute{
+				MarkdownDescription: "Number of packet slots of the pipe's queue (2-100). Set to `-1` to use the dummynet default. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"mask": schema.StringAttribute{
+				MarkdownDescription: "Create a dynamic pipe per source or destination address, so that each host gets the full bandwidth. Available values: `none`, `src-ip`, `dst-ip`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "src-ip", "dst-ip"),
+				},
+			},
+			"scheduler": schema.StringAttribute{
+				MarkdownDescription: "Scheduler used to share the bandwidth between the queues of the pipe. `wfq` is weighted fair queueing. Available values: `wfq`, `fifo`, `rr`, `qfq`, `fq_codel`, `fq_pie`. Defaults to `wfq`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("wfq"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("wfq", "fifo", "rr", "qfq", "fq_codel", "fq_pie"),
+				},
+			},
+			"delay": schema.Int64Attribute{
+				MarkdownDescription: "Delay, in milliseconds, added to the traffic sent through the pipe. Set to `-1` to add no delay. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the pipe.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the pipe.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func TrafficShaperPipeDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Traffic shaper pipes limit the bandwidth of the traffic sent through them. Traffic is sent to a pipe directly, or through one of its queues, by a traffic shaper rule.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this pipe is enabled.",
+				Computed:            true,
+			},
+			"bandwidth": dschema.Int64Attribute{
+				MarkdownDescription: "Bandwidth of the pipe, in `bandwidth_unit`.",
+				Computed:            true,
+			},
+			"bandwidth_unit": dschema.StringAttribute{
+				MarkdownDescription: "Unit of `bandwidth`.",
+				Computed:            true,
+			},
+			"queue": dschema.Int64Attribute{
+				MarkdownDescription: "Number of packet slots of the pipe's queue. `-1` if the dummynet default is used.",
+				Computed:            true,
+			},
+			"mask": dschema.StringAttribute{
+				MarkdownDescription: "Whether a dynamic pipe is created per source or destination address.",
+				Computed:            true,
+			},
+			"scheduler": dschema.StringAttribute{
+				MarkdownDescription: "Scheduler used to share the bandwidth between the queues of the pipe.",
+				Computed:            true,
+			},
+			"delay": dschema.Int64Attribute{
+				MarkdownDescription: "Delay, in milliseconds, added to the traffic sent through the pipe. `-1` if no delay is added.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the pipe.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func validateTrafficShaperPipeConfig(d *TrafficShaperPipeResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if d.Bandwidth.IsUnknown() || d.BandwidthUnit.IsUnknown() {
+		return diagnostics
+	}
+
+	unit := d.BandwidthUnit.ValueString()
+	if d.BandwidthUnit.IsNull() {
+		unit = "Mbit"
+	}
+
+	multiplier, ok := trafficShaperBandwidthUnits[unit]
+	if !ok {
+		// Reported by the attribute validator
+		return diagnostics
+	}
+
+	if d.Bandwidth.ValueInt64() > trafficShaperMaxBandwidth/multiplier {
+		diagnostics.AddAttributeError(
+			path.Root("bandwidth"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute bandwidth must not exceed %d %s (4294967295 bit/s), got: %d %s.",
+				trafficShaperMaxBandwidth/multiplier, unit, d.Bandwidth.ValueInt64(), unit),
+		)
+	}
+
+	return diagnostics
+}
+
+func convertTrafficShaperPipeSchemaToStruct(d *TrafficShaperPipeResourceModel) (*trafficshaper.Pipe, error) {
+	return &trafficshaper.Pipe{
+		Enabled:         tools.BoolToString(d.Enabled.ValueBool()),
+		Bandwidth:       tools.Int64ToString(d.Bandwidth.ValueInt64()),
+		BandwidthMetric: api.SelectedMap(d.BandwidthUnit.ValueString()),
+		Queue:           tools.Int64ToStringNegative(d.Queue.ValueInt64()),
+		Mask:            api.SelectedMap(d.Mask.ValueString()),
+		Scheduler:       api.SelectedMap(trafficShaperSchedulers[d.Scheduler.ValueString()]),
+		Delay:           tools.Int64ToStringNegative(d.Delay.ValueInt64()),
+		Description:     d.Description.ValueString(),
+	}, nil
+}
+
+func convertTrafficShaperPipeStructToSchema(d *trafficshaper.Pipe) (*TrafficShaperPipeResourceModel, error) {
+	scheduler := "wfq"
+	for name, value := range trafficShaperSchedulers {
+		if value == d.Scheduler.String() {
+			scheduler = name
+		}
+	}
+
+	return &TrafficShaperPipeResourceModel{
+		Enabled:       types.BoolValue(tools.StringToBool(d.Enabled)),
+		Bandwidth:     types.Int64Value(tools.StringToInt64(d.Bandwidth)),
+		BandwidthUnit: types.StringValue(d.BandwidthMetric.String()),
+		Queue:         types.Int64Value(tools.StringToInt64(d.Queue)),
+		Mask:          types.StringValue(d.Mask.String()),
+		Scheduler:     types.StringValue(scheduler),
+		Delay:         types.Int64Value(tools.StringToInt64(d.Delay)),
+		Description:   types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/trafficshaper"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// TrafficShaperQueueResourceModel describes the resource data model.
+type TrafficShaperQueueResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Pipe        types.String `tfsdk:"pipe"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Mask        types.String `tfsdk:"mask"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func trafficShaperQueueResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Traffic shaper queues share the bandwidth of a pipe between different types of traffic, according to their weight.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this queue. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"pipe": schema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe (see `opnsense_trafficshaper_pipe`) this queue belongs to.",
+				Required:            true,
+			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "Weight (1-100) of this queue. The bandwidth of the pipe is shared between its busy queues in proportion to their weight. Defaults to `100`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(100),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"mask": schema.StringAttribute{
+				MarkdownDescription: "Create a dynamic queue per source or destination address, so that each host gets the same share of the pipe. Available values: `none`, `src-ip`, `dst-ip`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "src-ip", "dst-ip"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the queue.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the queue.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func TrafficShaperQueueDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Traffic shaper queues share the bandwidth of a pipe between different types of traffic, according to their weight.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this queue is enabled.",
+				Computed:            true,
+			},
+			"pipe": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe this queue belongs to.",
+				Computed:            true,
+			},
+			"weight": dschema.Int64Attribute{
+				MarkdownDescription: "Weight of this queue.",
+				Computed:            true,
+			},
+			"mask": dschema.StringAttribute{
+				MarkdownDescription: "Whether a dynamic queue is created per source or destination address.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Description of the queue.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertTrafficShaperQueueSchemaToStruct(d *TrafficShaperQueueResourceModel) (*trafficshaper.Queue, error) {
+	return &trafficshaper.Queue{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Pipe:        api.SelectedMap(d.Pipe.ValueString()),
+		Weight:      tools.Int64ToString(d.Weight.ValueInt64()),
+		Mask:        api.SelectedMap(d.Mask.ValueString()),
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertTrafficShaperQueueStructToSchema(d *trafficshaper.Queue) (*TrafficShaperQueueResourceModel, error) {
+	return &TrafficShaperQueueResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Pipe:        types.StringValue(d.Pipe.String()),
+		Weight:      types.Int64Value(tools.StringToInt64(d.Weight)),
+		Mask:        types.StringValue(d.Mask.String()),
+		Description: types.StringValue(d.Description),
+	}, nil
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/trafficshaper"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+var trafficShaperProtocols = []string{
+	"ip", "ip4", "ip6", "udp", "tcp", "tcp_ack", "tcp_ack_not", "icmp", "ipv6-icmp", "igmp", "esp", "ah", "gre",
+}
+
+var trafficShaperDSCPs = []string{
+	"be", "ef",
+	"af11", "af12", "af13", "af21", "af22", "af23", "af31", "af32", "af33", "af41", "af42", "af43",
+	"cs1", "cs2", "cs3", "cs4", "cs5", "cs6", "cs7",
+}
+
+// trafficShaperDirections maps the rule directions to the values used by OPNsense.
+var trafficShaperDirections = map[string]string{
+	"both": "",
+	"in":   "in",
+	"out":  "out",
+}
+
+// TrafficShaperRuleResourceModel describes the resource data model.
+type TrafficShaperRuleResourceModel struct {
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Sequence   types.Int64  `tfsdk:"sequence"`
+	Interface  types.String `tfsdk:"interface"`
+	Interface2 types.String `tfsdk:"interface2"`
+	Protocol   types.String `tfsdk:"protocol"`
+
+	Source      *firewallLocation `tfsdk:"source"`
+	Destination *firewallLocation `tfsdk:"destination"`
+
+	DSCP        types.Set    `tfsdk:"dscp"`
+	Direction   types.String `tfsdk:"direction"`
+	Target      types.String `tfsdk:"target"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func trafficShaperRuleResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Traffic shaper rules send matching traffic to a pipe, or to a queue of a pipe.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this rule. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"sequence": schema.Int64Attribute{
+				MarkdownDescription: "Order in which the rules are evaluated (1-1000000). Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000000),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface the traffic must pass, e.g. `wan`.",
+				Required:            true,
+			},
+			"interface2": schema.StringAttribute{
+				MarkdownDescription: "Only match traffic that also passes this interface, e.g. `lan`. Matches traffic regardless of its other interface when not set.",
+				Optional:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Protocol of the traffic. Available values: `%s`. Defaults to `ip`.", strings.Join(trafficShaperProtocols, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ip"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(trafficShaperProtocols...),
+				},
+			},
+			"source": schema.SingleNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Default: objectdefault.StaticValue(
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"net":    types.StringType,
+							"port":   types.Int64Type,
+							"invert": types.BoolType,
+						},
+						map[string]attr.Value{
+							"net":    types.StringValue("any"),
+							"port":   types.Int64Value(-1),
+							"invert": types.BoolValue(false),
+						},
+					),
+				),
+				Attributes: map[string]schema.Attribute{
+					"net": schema.StringAttribute{
+						MarkdownDescription: "Specify the IP address or CIDR for the source of the packet for this rule. Defaults to `any`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("any"),
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "Specify the source port for this rule. Set to `-1` to match any port. Defaults to `-1`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(-1),
+					},
+					"invert": schema.BoolAttribute{
+						MarkdownDescription: "Use this option to invert the sense of the match. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"destination": schema.SingleNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Default: objectdefault.StaticValue(
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"net":    types.StringType,
+							"port":   types.Int64Type,
+							"invert": types.BoolType,
+						},
+						map[string]attr.Value{
+							"net":    types.StringValue("any"),
+							"port":   types.Int64Value(-1),
+							"invert": types.BoolValue(false),
+						},
+					),
+				),
+				Attributes: map[string]schema.Attribute{
+					"net": schema.StringAttribute{
+						MarkdownDescription: "Specify the IP address or CIDR for the destination of the packet for this rule. Defaults to `any`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("any"),
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "Specify the destination port for this rule. Set to `-1` to match any port. Defaults to `-1`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(-1),
+					},
+					"invert": schema.BoolAttribute{
+						MarkdownDescription: "Use this option to invert the sense of the match. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"dscp": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("Only match packets with one of these DSCP values, e.g. `ef` for VoIP. Matches any DSCP value when empty. Available values: `%s`. Defaults to `[]`.", strings.Join(trafficShaperDSCPs, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(trafficShaperDSCPs...)),
+				},
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Direction of the traffic on `interface`. Available values: `both`, `in`, `out`. Defaults to `both`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("both"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("both", "in", "out"),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe (see `opnsense_trafficshaper_pipe`) or queue (see `opnsense_trafficshaper_queue`) to send the matching traffic to.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func TrafficShaperRuleDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Traffic shaper rules send matching traffic to a pipe, or to a queue of a pipe.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this rule is enabled.",
+				Computed:            true,
+			},
+			"sequence": dschema.Int64Attribute{
+				MarkdownDescription: "Order in which the rules are evaluated.",
+				Computed:            true,
+			},
+			"interface": dschema.StringAttribute{
+				MarkdownDescription: "Interface the traffic must pass.",
+				Computed:            true,
+			},
+			"interface2": dschema.StringAttribute{
+				MarkdownDescription: "Second interface the traffic must pass.",
+				Computed:            true,
+			},
+			"protocol": dschema.StringAttribute{
+				MarkdownDescription: "Protocol of the traffic.",
+				Computed:            true,
+			},
+			"source": dschema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]dschema.Attribute{
+					"net": dschema.StringAttribute{
+						MarkdownDescription: "The IP address or CIDR for the source of the packet for this rule.",
+						Computed:            true,
+					},
+					"port": dschema.Int64Attribute{
+						MarkdownDescription: "The source port for this rule. `-1` if any port matches.",
+						Computed:            true,
+					},
+					"invert": dschema.BoolAttribute{
+						MarkdownDescription: "Whether the sense of the match is inverted.",
+						Computed:            true,
+					},
+				},
+			},
+			"destination": dschema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]dschema.Attribute{
+					"net": dschema.StringAttribute{
+						MarkdownDescription: "The IP address or CIDR for the destination of the packet for this rule.",
+						Computed:            true,
+					},
+					"port": dschema.Int64Attribute{
+						MarkdownDescription: "The destination port for this rule. `-1` if any port matches.",
+						Computed:            true,
+					},
+					"invert": dschema.BoolAttribute{
+						MarkdownDescription: "Whether the sense of the match is inverted.",
+						Computed:            true,
+					},
+				},
+			},
+			"dscp": dschema.SetAttribute{
+				MarkdownDescription: "DSCP values matched by this rule. Any value matches when empty.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"direction": dschema.StringAttribute{
+				MarkdownDescription: "Direction of the traffic on `interface`.",
+				Computed:            true,
+			},
+			"target": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the pipe or queue the matching traffic is sent to.",
+				Computed:            true,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// trafficShaperPort converts a port to the value used by OPNsense, which
+// uses `any` instead of an empty value.
+func trafficShaperPort(port types.Int64) string {
+	if port.ValueInt64() == -1 {
+		return "any"
+	}
+	return tools.Int64ToString(port.ValueInt64())
+}
+
+func convertTrafficShaperRuleSchemaToStruct(d *TrafficShaperRuleResourceModel) (*trafficshaper.Rule, error) {
+	var dscpList []string
+	d.DSCP.ElementsAs(context.Background(), &dscpList, false)
+
+	return &trafficshaper.Rule{
+		Enabled:         tools.BoolToString(d.Enabled.ValueBool()),
+		Sequence:        tools.Int64ToString(d.Sequence.ValueInt64()),
+		Interface:       api.SelectedMap(d.Interface.ValueString()),
+		Interface2:      api.SelectedMap(d.Interface2.ValueString()),
+		Protocol:        api.SelectedMap(d.Protocol.ValueString()),
+		Source:          d.Source.Net.ValueString(),
+		SourceNot:       tools.BoolToString(d.Source.Invert.ValueBool()),
+		SourcePort:      trafficShaperPort(d.Source.Port),
+		Destination:     d.Destination.Net.ValueString(),
+		DestinationNot:  tools.BoolToString(d.Destination.Invert.ValueBool()),
+		DestinationPort: trafficShaperPort(d.Destination.Port),
+		DSCP:            dscpList,
+		Direction:       api.SelectedMap(trafficShaperDirections[d.Direction.ValueString()]),
+		Target:          api.SelectedMap(d.Target.ValueString()),
+		Description:     d.Description.ValueString(),
+	}, nil
+}
+
+func convertTrafficShaperRuleStructToSchema(d *trafficshaper.Rule) (*TrafficShaperRuleResourceModel, error) {
+	direction := "both"
+	for name, value := range trafficShaperDirections {
+		if value == d.Direction.String() {
+			direction = name
+		}
+	}
+
+	return &TrafficShaperRuleResourceModel{
+		Enabled:    types.BoolValue(tools.StringToBool(d.Enabled)),
+		Sequence:   types.Int64Value(tools.StringToInt64(d.Sequence)),
+		Interface:  types.StringValue(d.Interface.String()),
+		Interface2: tools.StringOrNull(d.Interface2.String()),
+		Protocol:   types.StringValue(d.Protocol.String()),
+		Source: &firewallLocation{
+			Net:    types.StringValue(d.Source),
+			Port:   types.Int64Value(tools.StringToInt64(d.SourcePort)),
+			Invert: types.BoolValue(tools.StringToBool(d.SourceNot)),
+		},
+		Destination: &firewallLocation{
+			Net:    types.StringValue(d.Destination),
+			Port:   types.Int64Value(tools.StringToInt64(d.DestinationPort)),
+			Invert: types.BoolValue(tools.StringToBool(d.DestinationNot)),
+		},
+		DSCP:        tools.StringSliceToSet(d.DSCP),
+		Direction:   types.StringValue(direction),
+		Target:      types.StringValue(d.Target.String()),
+		Description: tools.StringOrNull(d.Description),
+	}, nil
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrustCAResource{}
+var _ resource.ResourceWithImportState = &TrustCAResource{}
+var _ resource.ResourceWithValidateConfig = &TrustCAResource{}
+var _ resource.ResourceWithModifyPlan = &TrustCAResource{}
+
+func NewTrustCAResource() resource.Resource {
+	return &TrustCAResource{}
+}
+
+// TrustCAResource defines the resource implementation.
+type TrustCAResource struct {
+	client opnsense.Client
+}
+
+func (r *TrustCAResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trust_ca"
+}
+
+func (r *TrustCAResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = trustCAResourceSchema()
+}
+
+func (r *TrustCAResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *TrustCAResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *TrustCAResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTrustGenerateConfig(data.Method, data.CommonName, data.Certificate)...)
+}
+
+func (r *TrustCAResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *TrustCAResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported CAs can only be renewed by configuring a new certificate
+	if plan.Method.ValueString() != "internal" || !trustRenewalDue(state.NotAfter, plan.RenewBeforeDays) {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("certificate authority expires at %s, planning replacement", state.NotAfter.ValueString()))
+
+	plan.Certificate = types.StringUnknown()
+	plan.PrivateKey = types.StringUnknown()
+	plan.RefId = types.StringUnknown()
+	plan.NotAfter = types.StringUnknown()
+	plan.Id = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, trustComputedPaths...)
+}
+
+func (r *TrustCAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TrustCAResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	ca, err := convertTrustCASchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse certificate authority, got error: %s", err))
+		return
+	}
+
+	// Add certificate authority to trust store
+	id, err := r.client.Trust().AddCA(ctx, ca)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create certificate authority, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Read back the generated certificate and reference ID
+	created, err := r.client.Trust().GetCA(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate authority, got error: %s", err))
+		return
+	}
+
+	caModel, err := convertTrustCAStructToSchema(created)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate authority, got error: %s", err))
+		return
+	}
+
+	mergeTrustCAState(caModel, data)
+	caModel.Id = data.Id
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &caModel)...)
+}
+
+func (r *TrustCAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TrustCAResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get certificate authority from OPNsense trust API
+	ca, err := r.client.Trust().GetCA(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("certificate authority not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate authority, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	caModel, err := convertTrustCAStructToSchema(ca)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate authority, got error: %s", err))
+		return
+	}
+
+	// Generation parameters are not stored by OPNsense, keep them unless
+	// the resource was just imported
+	if !data.Method.IsNull() {
+		mergeTrustCAState(caModel, data)
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	caModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &caModel)...)
+}
+
+func (r *TrustCAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TrustCAResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the description can be changed in place, so update the remote
+	// certificate authority rather than regenerating it
+	ca, err := r.client.Trust().GetCA(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate authority, got error: %s", err))
+		return
+	}
+	ca.Description = data.Description.ValueString()
+
+	// Update certificate authority in trust store
+	err = r.client.Trust().UpdateCA(ctx, data.Id.ValueString(), ca)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update certificate authority, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustCAResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TrustCAResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Trust().DeleteCA(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete certificate authority, got error: %s", err))
+		return
+	}
+}
+
+func (r *TrustCAResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
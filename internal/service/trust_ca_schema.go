@@ -0,0 +1,500 @@
+package service
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/trust"
+	"terraform-provider-opnsense/internal/tools"
+	"time"
+)
+
+// trustKeyTypes maps the key types to the values used by OPNsense.
+var trustKeyTypes = map[string]string{
+	"rsa-1024":      "1024",
+	"rsa-2048":      "2048",
+	"rsa-3072":      "3072",
+	"rsa-4096":      "4096",
+	"rsa-8192":      "8192",
+	"ec-prime256v1": "prime256v1",
+	"ec-secp384r1":  "secp384r1",
+	"ec-secp521r1":  "secp521r1",
+}
+
+// trustKeyTypeName returns the key type of an OPNsense key type value.
+func trustKeyTypeName(value string) string {
+	for name, v := range trustKeyTypes {
+		if v == value {
+			return name
+		}
+	}
+	return ""
+}
+
+var trustDigests = []string{"sha1", "sha224", "sha256", "sha384", "sha512"}
+
+var trustCountryRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// TrustCAResourceModel describes the resource data model.
+type TrustCAResourceModel struct {
+	Description        types.String `tfsdk:"description"`
+	Method             types.String `tfsdk:"method"`
+	Certificate        types.String `tfsdk:"certificate"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	ParentCA           types.String `tfsdk:"parent_ca"`
+	KeyType            types.String `tfsdk:"key_type"`
+	Digest             types.String `tfsdk:"digest"`
+	Lifetime           types.Int64  `tfsdk:"lifetime"`
+	CommonName         types.String `tfsdk:"common_name"`
+	Country            types.String `tfsdk:"country"`
+	State              types.String `tfsdk:"state"`
+	City               types.String `tfsdk:"city"`
+	Organization       types.String `tfsdk:"organization"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	Email              types.String `tfsdk:"email"`
+	RenewBeforeDays    types.Int64  `tfsdk:"renew_before_days"`
+	RefId              types.String `tfsdk:"refid"`
+	NotAfter           types.String `tfsdk:"not_after"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+// trustGenerateAttributes returns the attributes shared by the CA and
+// certificate resources which are only used to generate the certificate.
+// OPNsense doesn't store them, so any change requires a new certificate.
+func trustGenerateAttributes(defaultLifetime int64) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"key_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the generated private key. Only used when `method` is `internal`. Available values: `rsa-1024`, `rsa-2048`, `rsa-3072`, `rsa-4096`, `rsa-8192`, `ec-prime256v1`, `ec-secp384r1`, `ec-secp521r1`. Defaults to `rsa-2048`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("rsa-2048"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("rsa-1024", "rsa-2048", "rsa-3072", "rsa-4096", "rsa-8192", "ec-prime256v1", "ec-secp384r1", "ec-secp521r1"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"digest": schema.StringAttribute{
+			MarkdownDescription: "Digest algorithm used to sign the certificate. Only used when `method` is `internal`. Available values: `sha1`, `sha224`, `sha256`, `sha384`, `sha512`. Defaults to `sha256`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("sha256"),
+			Validators: []validator.String{
+				stringvalidator.OneOf(trustDigests...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"lifetime": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Lifetime of the certificate, in days. Only used when `method` is `internal`. Defaults to `%d`.", defaultLifetime),
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultLifetime),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"common_name": schema.StringAttribute{
+			MarkdownDescription: "Common name of the certificate, e.g. `fw.example.com`. Required when `method` is `internal`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"country": schema.StringAttribute{
+			MarkdownDescription: "Two letter country code of the certificate subject, e.g. `NL`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(trustCountryRegex, "must be a two letter, upper case, country code"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "State or province of the certificate subject.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"city": schema.StringAttribute{
+			MarkdownDescription: "City of the certificate subject.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"organization": schema.StringAttribute{
+			MarkdownDescription: "Organization of the certificate subject.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"organizational_unit": schema.StringAttribute{
+			MarkdownDescription: "Organizational unit of the certificate subject.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email address of the certificate subject.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"renew_before_days": schema.Int64Attribute{
+			MarkdownDescription: "Plan to replace the certificate when it expires within this many days. Only used when `method` is `internal`. Set to `0` to disable. Defaults to `0`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"refid": schema.StringAttribute{
+			MarkdownDescription: "Reference ID of the certificate, as used by other resources (e.g. `opnsense_openvpn_instance`) to refer to it.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"not_after": schema.StringAttribute{
+			MarkdownDescription: "Expiry time of the certificate, in RFC3339 format.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "UUID of the certificate.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// trustGenerateDataSourceAttributes returns the data source counterpart of
+// trustGenerateAttributes.
+func trustGenerateDataSourceAttributes() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"id": dschema.StringAttribute{
+			MarkdownDescription: "UUID of the resource.",
+			Required:            true,
+		},
+		"key_type": dschema.StringAttribute{
+			MarkdownDescription: "Type of the generated private key.",
+			Computed:            true,
+		},
+		"digest": dschema.StringAttribute{
+			MarkdownDescription: "Digest algorithm used to sign the certificate.",
+			Computed:            true,
+		},
+		"lifetime": dschema.Int64Attribute{
+			MarkdownDescription: "Lifetime of the certificate, in days.",
+			Computed:            true,
+		},
+		"common_name": dschema.StringAttribute{
+			MarkdownDescription: "Common name of the certificate.",
+			Computed:            true,
+		},
+		"country": dschema.StringAttribute{
+			MarkdownDescription: "Two letter country code of the certificate subject.",
+			Computed:            true,
+		},
+		"state": dschema.StringAttribute{
+			MarkdownDescription: "State or province of the certificate subject.",
+			Computed:            true,
+		},
+		"city": dschema.StringAttribute{
+			MarkdownDescription: "City of the certificate subject.",
+			Computed:            true,
+		},
+		"organization": dschema.StringAttribute{
+			MarkdownDescription: "Organization of the certificate subject.",
+			Computed:            true,
+		},
+		"organizational_unit": dschema.StringAttribute{
+			MarkdownDescription: "Organizational unit of the certificate subject.",
+			Computed:            true,
+		},
+		"email": dschema.StringAttribute{
+			MarkdownDescription: "Email address of the certificate subject.",
+			Computed:            true,
+		},
+		"renew_before_days": dschema.Int64Attribute{
+			MarkdownDescription: "Not used by the data source, always `0`.",
+			Computed:            true,
+		},
+		"refid": dschema.StringAttribute{
+			MarkdownDescription: "Reference ID of the certificate.",
+			Computed:            true,
+		},
+		"not_after": dschema.StringAttribute{
+			MarkdownDescription: "Expiry time of the certificate, in RFC3339 format.",
+			Computed:            true,
+		},
+	}
+}
+
+func trustCAResourceSchema() schema.Schema {
+	attributes := trustGenerateAttributes(3650)
+	attributes["description"] = schema.StringAttribute{
+		MarkdownDescription: "Description of the certificate authority.",
+		Required:            true,
+	}
+	attributes["method"] = schema.StringAttribute{
+		MarkdownDescription: "How to create the certificate authority. Use `internal` to generate a new CA, or `import` to import an existing one from `certificate` and `private_key`. Defaults to `internal`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("internal"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("internal", "import"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["certificate"] = schema.StringAttribute{
+		MarkdownDescription: "Certificate of the CA, in PEM format. Required when `method` is `import`, otherwise set to the generated certificate.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["private_key"] = schema.StringAttribute{
+		MarkdownDescription: "Private key of the CA, in PEM format. Only used when `method` is `import`, otherwise set to the generated private key. Without a private key, the CA cannot be used to sign certificates.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["parent_ca"] = schema.StringAttribute{
+		MarkdownDescription: "Reference ID of the CA to sign this CA with, to create an intermediate CA. Only used when `method` is `internal`. Creates a self-signed root CA when not set.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	return schema.Schema{
+		MarkdownDescription: "Certificate authorities are used to sign certificates, e.g. for OpenVPN, IPsec or the web GUI.",
+		Attributes:          attributes,
+	}
+}
+
+func TrustCADataSourceSchema() dschema.Schema {
+	attributes := trustGenerateDataSourceAttributes()
+	attributes["description"] = dschema.StringAttribute{
+		MarkdownDescription: "Description of the certificate authority.",
+		Computed:            true,
+	}
+	attributes["method"] = dschema.StringAttribute{
+		MarkdownDescription: "How the certificate authority was created.",
+		Computed:            true,
+	}
+	attributes["certificate"] = dschema.StringAttribute{
+		MarkdownDescription: "Certificate of the CA, in PEM format.",
+		Computed:            true,
+	}
+	attributes["private_key"] = dschema.StringAttribute{
+		MarkdownDescription: "Private key of the CA, in PEM format.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["parent_ca"] = dschema.StringAttribute{
+		MarkdownDescription: "Reference ID of the CA this CA is signed with.",
+		Computed:            true,
+	}
+
+	return dschema.Schema{
+		MarkdownDescription: "Certificate authorities are used to sign certificates, e.g. for OpenVPN, IPsec or the web GUI.",
+		Attributes:          attributes,
+	}
+}
+
+// trustCertificateNotAfter returns the expiry time of a certificate, from
+// either its PEM or base64 encoded PEM form.
+func trustCertificateNotAfter(certificate string, encoded string) (types.String, error) {
+	if certificate == "" && encoded != "" {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return types.StringNull(), err
+		}
+		certificate = string(decoded)
+	}
+
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return types.StringNull(), nil
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339)), nil
+}
+
+// trustRenewalDue returns whether a certificate expires within the renewal
+// window.
+func trustRenewalDue(notAfter types.String, renewBeforeDays types.Int64) bool {
+	if notAfter.IsNull() || notAfter.IsUnknown() || renewBeforeDays.ValueInt64() <= 0 {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, notAfter.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return time.Until(expiry) < time.Duration(renewBeforeDays.ValueInt64())*24*time.Hour
+}
+
+// trustComputedPaths are the attributes that are unknown until a
+// replacement certificate is created.
+var trustComputedPaths = []path.Path{
+	path.Root("refid"),
+	path.Root("not_after"),
+	path.Root("id"),
+}
+
+func validateTrustGenerateConfig(method types.String, commonName types.String, certificate types.String) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	switch method.ValueString() {
+	case "import":
+		if certificate.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("certificate"),
+				"Missing Attribute Configuration",
+				"Expected certificate to be configured when method is import.",
+			)
+		}
+	default:
+		if commonName.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("common_name"),
+				"Missing Attribute Configuration",
+				"Expected common_name to be configured when method is internal.",
+			)
+		}
+	}
+
+	return diagnostics
+}
+
+// mergeTrustCAState copies the attributes OPNsense doesn't store from the
+// prior state.
+func mergeTrustCAState(d *TrustCAResourceModel, prior *TrustCAResourceModel) {
+	d.Method = prior.Method
+	d.KeyType = prior.KeyType
+	d.Digest = prior.Digest
+	d.Lifetime = prior.Lifetime
+	d.CommonName = prior.CommonName
+	d.Country = prior.Country
+	d.State = prior.State
+	d.City = prior.City
+	d.Organization = prior.Organization
+	d.OrganizationalUnit = prior.OrganizationalUnit
+	d.Email = prior.Email
+	d.RenewBeforeDays = prior.RenewBeforeDays
+
+	if d.Certificate.IsNull() {
+		d.Certificate = prior.Certificate
+	}
+	if d.PrivateKey.IsNull() {
+		d.PrivateKey = prior.PrivateKey
+	}
+}
+
+func convertTrustCASchemaToStruct(d *TrustCAResourceModel) (*trust.CA, error) {
+	action := "internal"
+	if d.Method.ValueString() == "import" {
+		action = "existing"
+	}
+
+	return &trust.CA{
+		Description:        d.Description.ValueString(),
+		Action:             api.SelectedMap(action),
+		ParentCA:           api.SelectedMap(d.ParentCA.ValueString()),
+		KeyType:            api.SelectedMap(trustKeyTypes[d.KeyType.ValueString()]),
+		Digest:             api.SelectedMap(d.Digest.ValueString()),
+		Lifetime:           tools.Int64ToString(d.Lifetime.ValueInt64()),
+		CommonName:         d.CommonName.ValueString(),
+		Country:            api.SelectedMap(d.Country.ValueString()),
+		State:              d.State.ValueString(),
+		City:               d.City.ValueString(),
+		Organization:       d.Organization.ValueString(),
+		OrganizationalUnit: d.OrganizationalUnit.ValueString(),
+		Email:              d.Email.ValueString(),
+		Certificate:        d.Certificate.ValueString(),
+		PrivateKey:         d.PrivateKey.ValueString(),
+	}, nil
+}
+
+func convertTrustCAStructToSchema(d *trust.CA) (*TrustCAResourceModel, error) {
+	method := "internal"
+	if d.Action.String() == "existing" {
+		method = "import"
+	}
+
+	notAfter, err := trustCertificateNotAfter(d.Certificate, d.EncodedCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrustCAResourceModel{
+		Description:        types.StringValue(d.Description),
+		Method:             types.StringValue(method),
+		Certificate:        tools.StringOrNull(d.Certificate),
+		PrivateKey:         tools.StringOrNull(d.PrivateKey),
+		ParentCA:           tools.StringOrNull(d.ParentCA.String()),
+		KeyType:            tools.StringOrNull(trustKeyTypeName(d.KeyType.String())),
+		Digest:             tools.StringOrNull(d.Digest.String()),
+		Lifetime:           tools.StringToInt64Null(d.Lifetime),
+		CommonName:         tools.StringOrNull(d.CommonName),
+		Country:            tools.StringOrNull(d.Country.String()),
+		State:              tools.StringOrNull(d.State),
+		City:               tools.StringOrNull(d.City),
+		Organization:       tools.StringOrNull(d.Organization),
+		OrganizationalUnit: tools.StringOrNull(d.OrganizationalUnit),
+		Email:              tools.StringOrNull(d.Email),
+		RenewBeforeDays:    types.Int64Value(0),
+		RefId:              types.StringValue(d.RefId),
+		NotAfter:           notAfter,
+	}, nil
+}
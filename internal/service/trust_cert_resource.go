@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/browningluke/opnsense-go/pkg/errs"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrustCertResource{}
+var _ resource.ResourceWithImportState = &TrustCertResource{}
+var _ resource.ResourceWithValidateConfig = &TrustCertResource{}
+var _ resource.ResourceWithModifyPlan = &TrustCertResource{}
+
+func NewTrustCertResource() resource.Resource {
+	return &TrustCertResource{}
+}
+
+// TrustCertResource defines the resource implementation.
+type TrustCertResource struct {
+	client opnsense.Client
+}
+
+func (r *TrustCertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trust_cert"
+}
+
+func (r *TrustCertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = trustCertResourceSchema()
+}
+
+func (r *TrustCertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *TrustCertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *TrustCertResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTrustCertConfig(data)...)
+}
+
+func (r *TrustCertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *TrustCertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported certificates can only be renewed by configuring a new certificate
+	if plan.Method.ValueString() != "internal" || !trustRenewalDue(state.NotAfter, plan.RenewBeforeDays) {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("certificate expires at %s, planning replacement", state.NotAfter.ValueString()))
+
+	plan.Certificate = types.StringUnknown()
+	plan.PrivateKey = types.StringUnknown()
+	plan.RefId = types.StringUnknown()
+	plan.NotAfter = types.StringUnknown()
+	plan.Id = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, trustComputedPaths...)
+}
+
+func (r *TrustCertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TrustCertResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	cert, err := convertTrustCertSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse certificate, got error: %s", err))
+		return
+	}
+
+	// Add certificate to trust store
+	id, err := r.client.Trust().AddCert(ctx, cert)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create certificate, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with ID from OPNsense
+	data.Id = types.StringValue(id)
+
+	// Read back the generated certificate and reference ID
+	created, err := r.client.Trust().GetCert(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate, got error: %s", err))
+		return
+	}
+
+	certModel, err := convertTrustCertStructToSchema(created)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate, got error: %s", err))
+		return
+	}
+
+	mergeTrustCertState(certModel, data)
+	certModel.Id = data.Id
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &certModel)...)
+}
+
+func (r *TrustCertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TrustCertResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get certificate from OPNsense trust API
+	cert, err := r.client.Trust().GetCert(ctx, data.Id.ValueString())
+	if err != nil {
+		var notFoundError *errs.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("certificate not present in remote, removing from state"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	certModel, err := convertTrustCertStructToSchema(cert)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate, got error: %s", err))
+		return
+	}
+
+	// Generation parameters are not stored by OPNsense, keep them unless
+	// the resource was just imported
+	if !data.Method.IsNull() {
+		mergeTrustCertState(certModel, data)
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	certModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &certModel)...)
+}
+
+func (r *TrustCertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TrustCertResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the description can be changed in place, so update the remote
+	// certificate rather than regenerating it
+	cert, err := r.client.Trust().GetCert(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read certificate, got error: %s", err))
+		return
+	}
+	cert.Description = data.Description.ValueString()
+
+	// Update certificate in trust store
+	err = r.client.Trust().UpdateCert(ctx, data.Id.ValueString(), cert)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update certificate, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustCertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TrustCertResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Trust().DeleteCert(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete certificate, got error: %s", err))
+		return
+	}
+}
+
+func (r *TrustCertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/trust"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// trustCertTypes maps the certificate types to the values used by OPNsense.
+var trustCertTypes = map[string]string{
+	"client":   "usr_cert",
+	"server":   "server_cert",
+	"combined": "combined_server_client",
+	"ca":       "v3_ca",
+}
+
+// TrustCertResourceModel describes the resource data model.
+type TrustCertResourceModel struct {
+	Description        types.String `tfsdk:"description"`
+	Method             types.String `tfsdk:"method"`
+	CA                 types.String `tfsdk:"ca"`
+	Type               types.String `tfsdk:"type"`
+	Certificate        types.String `tfsdk:"certificate"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	KeyType            types.String `tfsdk:"key_type"`
+	Digest             types.String `tfsdk:"digest"`
+	Lifetime           types.Int64  `tfsdk:"lifetime"`
+	CommonName         types.String `tfsdk:"common_name"`
+	Country            types.String `tfsdk:"country"`
+	State              types.String `tfsdk:"state"`
+	City               types.String `tfsdk:"city"`
+	Organization       types.String `tfsdk:"organization"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	Email              types.String `tfsdk:"email"`
+	DNSNames           types.Set    `tfsdk:"dns_names"`
+	IPAddresses        types.Set    `tfsdk:"ip_addresses"`
+	URIs               types.Set    `tfsdk:"uris"`
+	EmailAddresses     types.Set    `tfsdk:"email_addresses"`
+	RenewBeforeDays    types.Int64  `tfsdk:"renew_before_days"`
+	RefId              types.String `tfsdk:"refid"`
+	NotAfter           types.String `tfsdk:"not_after"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func trustCertResourceSchema() schema.Schema {
+	attributes := trustGenerateAttributes(397)
+	attributes["description"] = schema.StringAttribute{
+		MarkdownDescription: "Description of the certificate.",
+		Required:            true,
+	}
+	attributes["method"] = schema.StringAttribute{
+		MarkdownDescription: "How to create the certificate. Use `internal` to generate a new certificate signed by `ca`, or `import` to import an existing one from `certificate` and `private_key`. Defaults to `internal`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("internal"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("internal", "import"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["ca"] = schema.StringAttribute{
+		MarkdownDescription: "Reference ID of the CA (see `opnsense_trust_ca`) to sign the certificate with. Required when `method` is `internal`.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["type"] = schema.StringAttribute{
+		MarkdownDescription: "Type of certificate to generate. Only used when `method` is `internal`. Available values: `client`, `server`, `combined`, `ca`. Defaults to `server`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("server"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("client", "server", "combined", "ca"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["certificate"] = schema.StringAttribute{
+		MarkdownDescription: "Certificate, in PEM format. Required when `method` is `import`, otherwise set to the generated certificate.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["private_key"] = schema.StringAttribute{
+		MarkdownDescription: "Private key of the certificate, in PEM format. Only used when `method` is `import`, otherwise set to the generated private key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["dns_names"] = schema.SetAttribute{
+		MarkdownDescription: "DNS subject alternative names, e.g. `fw.example.com`. Only used when `method` is `internal`. Defaults to `[]`.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		Default:             setdefault.StaticValue(tools.EmptySetValue()),
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["ip_addresses"] = schema.SetAttribute{
+		MarkdownDescription: "IP address subject alternative names. Only used when `method` is `internal`. Defaults to `[]`.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		Default:             setdefault.StaticValue(tools.EmptySetValue()),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(validators.IsIP()),
+		},
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["uris"] = schema.SetAttribute{
+		MarkdownDescription: "URI subject alternative names. Only used when `method` is `internal`. Defaults to `[]`.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		Default:             setdefault.StaticValue(tools.EmptySetValue()),
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["email_addresses"] = schema.SetAttribute{
+		MarkdownDescription: "Email address subject alternative names. Only used when `method` is `internal`. Defaults to `[]`.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		Default:             setdefault.StaticValue(tools.EmptySetValue()),
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.RequiresReplace(),
+		},
+	}
+
+	return schema.Schema{
+		MarkdownDescription: "Certificates are used by services such as the web GUI, OpenVPN, IPsec or HAProxy.",
+		Attributes:          attributes,
+	}
+}
+
+func TrustCertDataSourceSchema() dschema.Schema {
+	attributes := trustGenerateDataSourceAttributes()
+	attributes["description"] = dschema.StringAttribute{
+		MarkdownDescription: "Description of the certificate.",
+		Computed:            true,
+	}
+	attributes["method"] = dschema.StringAttribute{
+		MarkdownDescription: "How the certificate was created.",
+		Computed:            true,
+	}
+	attributes["ca"] = dschema.StringAttribute{
+		MarkdownDescription: "Reference ID of the CA the certificate is signed with.",
+		Computed:            true,
+	}
+	attributes["type"] = dschema.StringAttribute{
+		MarkdownDescription: "Type of the certificate.",
+		Computed:            true,
+	}
+	attributes["certificate"] = dschema.StringAttribute{
+		MarkdownDescription: "Certificate, in PEM format.",
+		Computed:            true,
+	}
+	attributes["private_key"] = dschema.StringAttribute{
+		MarkdownDescription: "Private key of the certificate, in PEM format.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["dns_names"] = dschema.SetAttribute{
+		MarkdownDescription: "DNS subject alternative names.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["ip_addresses"] = dschema.SetAttribute{
+		MarkdownDescription: "IP address subject alternative names.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["uris"] = dschema.SetAttribute{
+		MarkdownDescription: "URI subject alternative names.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["email_addresses"] = dschema.SetAttribute{
+		MarkdownDescription: "Email address subject alternative names.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+
+	return dschema.Schema{
+		MarkdownDescription: "Certificates are used by services such as the web GUI, OpenVPN, IPsec or HAProxy.",
+		Attributes:          attributes,
+	}
+}
+
+func validateTrustCertConfig(d *TrustCertResourceModel) diag.Diagnostics {
+	diagnostics := validateTrustGenerateConfig(d.Method, d.CommonName, d.Certificate)
+
+	if d.Method.ValueString() != "import" && d.CA.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("ca"),
+			"Missing Attribute Configuration",
+			"Expected ca to be configured when method is internal.",
+		)
+	}
+
+	return diagnostics
+}
+
+// mergeTrustCertState copies the attributes OPNsense doesn't store from the
+// prior state.
+func mergeTrustCertState(d *TrustCertResourceModel, prior *TrustCertResourceModel) {
+	d.Method = prior.Method
+	d.Type = prior.Type
+	d.KeyType = prior.KeyType
+	d.Digest = prior.Digest
+	d.Lifetime = prior.Lifetime
+	d.CommonName = prior.CommonName
+	d.Country = prior.Country
+	d.State = prior.State
+	d.City = prior.City
+	d.Organization = prior.Organization
+	d.OrganizationalUnit = prior.OrganizationalUnit
+	d.Email = prior.Email
+	d.DNSNames = prior.DNSNames
+	d.IPAddresses = prior.IPAddresses
+	d.URIs = prior.URIs
+	d.EmailAddresses = prior.EmailAddresses
+	d.RenewBeforeDays = prior.RenewBeforeDays
+
+	if d.Certificate.IsNull() {
+		d.Certificate = prior.Certificate
+	}
+	if d.PrivateKey.IsNull() {
+		d.PrivateKey = prior.PrivateKey
+	}
+}
+
+func convertTrustCertSchemaToStruct(d *TrustCertResourceModel) (*trust.Cert, error) {
+	var dnsList, ipList, uriList, emailList []string
+
+	ctx := context.Background()
+	d.DNSNames.ElementsAs(ctx, &dnsList, false)
+	d.IPAddresses.ElementsAs(ctx, &ipList, false)
+	d.URIs.ElementsAs(ctx, &uriList, false)
+	d.EmailAddresses.ElementsAs(ctx, &emailList, false)
+
+	action := "internal"
+	if d.Method.ValueString() == "import" {
+		action = "import"
+	}
+
+	return &trust.Cert{
+		Description:        d.Description.ValueString(),
+		Action:             api.SelectedMap(action),
+		CA:                 api.SelectedMap(d.CA.ValueString()),
+		CertType:           api.SelectedMap(trustCertTypes[d.Type.ValueString()]),
+		KeyType:            api.SelectedMap(trustKeyTypes[d.KeyType.ValueString()]),
+		Digest:             api.SelectedMap(d.Digest.ValueString()),
+		Lifetime:           tools.Int64ToString(d.Lifetime.ValueInt64()),
+		CommonName:         d.CommonName.ValueString(),
+		Country:            api.SelectedMap(d.Country.ValueString()),
+		State:              d.State.ValueString(),
+		City:               d.City.ValueString(),
+		Organization:       d.Organization.ValueString(),
+		OrganizationalUnit: d.OrganizationalUnit.ValueString(),
+		Email:              d.Email.ValueString(),
+		AltNamesDNS:        strings.Join(dnsList, "\n"),
+		AltNamesIP:         strings.Join(ipList, "\n"),
+		AltNamesURI:        strings.Join(uriList, "\n"),
+		AltNamesEmail:      strings.Join(emailList, "\n"),
+		Certificate:        d.Certificate.ValueString(),
+		PrivateKey:         d.PrivateKey.ValueString(),
+	}, nil
+}
+
+func convertTrustCertStructToSchema(d *trust.Cert) (*TrustCertResourceModel, error) {
+	method := "internal"
+	if d.Action.String() == "import" {
+		method = "import"
+	}
+
+	certType := ""
+	for name, value := range trustCertTypes {
+		if value == d.CertType.String() {
+			certType = name
+		}
+	}
+
+	notAfter, err := trustCertificateNotAfter(d.Certificate, d.EncodedCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrustCertResourceModel{
+		Description:        types.StringValue(d.Description),
+		Method:             types.StringValue(method),
+		CA:                 tools.StringOrNull(d.CA.String()),
+		Type:               tools.StringOrNull(certType),
+		Certificate:        tools.StringOrNull(d.Certificate),
+		PrivateKey:         tools.StringOrNull(d.PrivateKey),
+		KeyType:            tools.StringOrNull(trustKeyTypeName(d.KeyType.String())),
+		Digest:             tools.StringOrNull(d.Digest.String()),
+		Lifetime:           tools.StringToInt64Null(d.Lifetime),
+		CommonName:         tools.StringOrNull(d.CommonName),
+		Country:            tools.StringOrNull(d.Country.String()),
+		State:              tools.StringOrNull(d.State),
+		City:               tools.StringOrNull(d.City),
+		Organization:       tools.StringOrNull(d.Organization),
+		OrganizationalUnit: tools.StringOrNull(d.OrganizationalUnit),
+		Email:              tools.StringOrNull(d.Email),
+		DNSNames:           tools.StringSliceToSet(strings.Fields(d.AltNamesDNS)),
+		IPAddresses:        tools.StringSliceToSet(strings.Fields(d.AltNamesIP)),
+		URIs:               tools.StringSliceToSet(strings.Fields(d.AltNamesURI)),
+		EmailAddresses:     tools.StringSliceToSet(strings.Fields(d.AltNamesEmail)),
+		RenewBeforeDays:    types.Int64Value(0),
+		RefId:              types.StringValue(d.RefId),
+		NotAfter:           notAfter,
+	}, nil
+}
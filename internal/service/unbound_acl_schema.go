@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// UnboundACLResourceModel describes the resource data model.
+type UnboundACLResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Name        types.String `tfsdk:"name"`
+	Action      types.String `tfsdk:"action"`
+	Networks    types.Set    `tfsdk:"networks"`
+	Description types.String `tfsdk:"description"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func unboundACLResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Access lists define which networks are allowed to query the Unbound DNS resolver. Queries from networks that are not matched by any access list are handled by the default action.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this access list. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the access list.",
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Choose what to do with DNS requests that match the criteria specified below. `deny` drops the query, `refuse` answers with REFUSED, `allow_snoop` additionally allows recursive and non-recursive access (e.g. for cache snooping). The `*_non_local` variants only apply to queries for data that is not local. Available values: `allow`, `deny`, `refuse`, `allow_snoop`, `deny_non_local`, `refuse_non_local`. Defaults to `allow`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("allow"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny", "refuse", "allow_snoop", "deny_non_local", "refuse_non_local"),
+				},
+			},
+			"networks": schema.SetAttribute{
+				MarkdownDescription: "Networks this access list applies to, in CIDR notation (e.g. `[\"192.168.1.0/24\", \"fd00::/64\"]`). Must specify at least 1.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the access list.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func UnboundACLDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Access lists define which networks are allowed to query the Unbound DNS resolver. Queries from networks that are not matched by any access list are handled by the default action.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this access list is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the access list.",
+				Computed:            true,
+			},
+			"action": dschema.StringAttribute{
+				MarkdownDescription: "Choose what to do with DNS requests that match the criteria specified below. Available values: `allow`, `deny`, `refuse`, `allow_snoop`, `deny_non_local`, `refuse_non_local`.",
+				Computed:            true,
+			},
+			"networks": dschema.SetAttribute{
+				MarkdownDescription: "Networks this access list applies to, in CIDR notation.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": dschema.StringAttribute{
+				MarkdownDescription: "Optional description here for your reference (not parsed).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertUnboundACLSchemaToStruct(d *UnboundACLResourceModel) (*unbound.ACL, error) {
+	// Parse 'Networks'
+	var networksList []string
+	d.Networks.ElementsAs(context.Background(), &networksList, false)
+
+	return &unbound.ACL{
+		Enabled:     tools.BoolToString(d.Enabled.ValueBool()),
+		Name:        d.Name.ValueString(),
+		Action:      api.SelectedMap(d.Action.ValueString()),
+		Networks:    networksList,
+		Description: d.Description.ValueString(),
+	}, nil
+}
+
+func convertUnboundACLStructToSchema(d *unbound.ACL) (*UnboundACLResourceModel, error) {
+	model := &UnboundACLResourceModel{
+		Enabled:     types.BoolValue(tools.StringToBool(d.Enabled)),
+		Name:        types.StringValue(d.Name),
+		Action:      types.StringValue(d.Action.String()),
+		Networks:    types.SetNull(types.StringType),
+		Description: tools.StringOrNull(d.Description),
+	}
+
+	// Parse 'Networks'
+	var networksList []attr.Value
+	for _, i := range d.Networks {
+		networksList = append(networksList, basetypes.NewStringValue(i))
+	}
+	networksTypeList, _ := types.SetValue(types.StringType, networksList)
+	model.Networks = networksTypeList
+
+	return model, nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UnboundDNSBLResource{}
+var _ resource.ResourceWithImportState = &UnboundDNSBLResource{}
+
+func NewUnboundDNSBLResource() resource.Resource {
+	return &UnboundDNSBLResource{}
+}
+
+// UnboundDNSBLResource defines the resource implementation.
+type UnboundDNSBLResource struct {
+	client opnsense.Client
+}
+
+func (r *UnboundDNSBLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_dnsbl"
+}
+
+func (r *UnboundDNSBLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = unboundDNSBLResourceSchema()
+}
+
+func (r *UnboundDNSBLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *UnboundDNSBLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *UnboundDNSBLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	dnsbl, err := convertUnboundDNSBLSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse dnsbl, got error: %s", err))
+		return
+	}
+
+	// DNSBL always exists in OPNsense, so creating it means updating the settings
+	err = r.client.Unbound().UpdateDNSBL(ctx, dnsbl)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create dnsbl, got error: %s", err))
+		return
+	}
+
+	// Tag new resource with the singleton ID
+	data.Id = types.StringValue(unboundDNSBLId)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UnboundDNSBLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *UnboundDNSBLResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get dnsbl from OPNsense unbound API
+	dnsbl, err := r.client.Unbound().GetDNSBL(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read dnsbl, got error: %s", err))
+		return
+	}
+
+	// Convert OPNsense struct to TF schema
+	dnsblModel, err := convertUnboundDNSBLStructToSchema(dnsbl)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read dnsbl, got error: %s", err))
+		return
+	}
+
+	// ID cannot be added by convert... func, have to add here
+	dnsblModel.Id = types.StringValue(unboundDNSBLId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &dnsblModel)...)
+}
+
+func (r *UnboundDNSBLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *UnboundDNSBLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert TF schema OPNsense struct
+	dnsbl, err := convertUnboundDNSBLSchemaToStruct(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to parse dnsbl, got error: %s", err))
+		return
+	}
+
+	// Update dnsbl in unbound
+	err = r.client.Unbound().UpdateDNSBL(ctx, dnsbl)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update dnsbl, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UnboundDNSBLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *UnboundDNSBLResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// DNSBL cannot be deleted, so disable it and clear all lists instead
+	err := r.client.Unbound().UpdateDNSBL(ctx, &unbound.DNSBL{
+		Enabled:    tools.BoolToString(false),
+		SafeSearch: tools.BoolToString(false),
+		NXDomain:   tools.BoolToString(false),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete dnsbl, got error: %s", err))
+		return
+	}
+}
+
+func (r *UnboundDNSBLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
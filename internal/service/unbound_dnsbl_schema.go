@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// unboundDNSBLId is the ID of the (singleton) DNSBL configuration.
+const unboundDNSBLId = "dnsbl"
+
+// unboundDNSBLEntryValidator rejects commas in list entries, since OPNsense
+// stores each list as a comma-separated string, which would split the entry.
+var unboundDNSBLEntryValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^[^,]*$`), "must not contain commas")
+
+// UnboundDNSBLResourceModel describes the resource data model.
+type UnboundDNSBLResourceModel struct {
+	Enabled    types.Bool `tfsdk:"enabled"`
+	SafeSearch types.Bool `tfsdk:"safesearch"`
+
+	Type       types.Set `tfsdk:"type"`
+	Lists      types.Set `tfsdk:"lists"`
+	Whitelists types.Set `tfsdk:"whitelists"`
+	Blocklists types.Set `tfsdk:"blocklists"`
+	Wildcards  types.Set `tfsdk:"wildcards"`
+
+	Address  types.String `tfsdk:"address"`
+	NXDomain types.Bool   `tfsdk:"nxdomain"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func unboundDNSBLResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "DNS blocklists (DNSBL) can be used to block advertisements, trackers and malware domains on the Unbound DNS resolver. There is only one DNSBL configuration per OPNsense host, destroying this resource disables DNSBL and clears all lists.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the usage of DNS blocklists. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"safesearch": schema.BoolAttribute{
+				MarkdownDescription: "Force the usage of SafeSearch on Google, DuckDuckGo, Bing, Qwant, PixaBay and YouTube. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"type": schema.SetAttribute{
+				MarkdownDescription: "Set of predefined blocklists to use, by their OPNsense key (e.g. `[\"ag\", \"el\"]` for AdGuard and EasyList). Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"lists": schema.SetAttribute{
+				MarkdownDescription: "Set of URLs of additional blocklists to download. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(unboundDNSBLEntryValidator),
+				},
+			},
+			"whitelists": schema.SetAttribute{
+				MarkdownDescription: "Set of domains to exclude from the blocklists. Regular expressions are supported (e.g. `.*\\.example\\.com`), but cannot contain commas, so use e.g. `[0-9][0-9]?[0-9]?` instead of `[0-9]{1,3}`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(unboundDNSBLEntryValidator),
+				},
+			},
+			"blocklists": schema.SetAttribute{
+				MarkdownDescription: "Set of domains to block in addition to the downloaded blocklists. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(unboundDNSBLEntryValidator),
+				},
+			},
+			"wildcards": schema.SetAttribute{
+				MarkdownDescription: "Set of domains to block, including all of their subdomains. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(unboundDNSBLEntryValidator),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Destination IP address for blocked domains. Set to `\"\"` to use `0.0.0.0`. Has no effect when `nxdomain` is `true`. Defaults to `\"\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"nxdomain": schema.BoolAttribute{
+				MarkdownDescription: "Answer queries for blocked domains with `NXDOMAIN` instead of the destination address. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the DNSBL configuration. Always `dnsbl`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func convertUnboundDNSBLSchemaToStruct(d *UnboundDNSBLResourceModel) (*unbound.DNSBL, error) {
+	// Parse 'Type'
+	var typeList []string
+	d.Type.ElementsAs(context.Background(), &typeList, false)
+
+	// Parse 'Lists'
+	var listsList []string
+	d.Lists.ElementsAs(context.Background(), &listsList, false)
+
+	// Parse 'Whitelists'
+	var whitelistsList []string
+	d.Whitelists.ElementsAs(context.Background(), &whitelistsList, false)
+
+	// Parse 'Blocklists'
+	var blocklistsList []string
+	d.Blocklists.ElementsAs(context.Background(), &blocklistsList, false)
+
+	// Parse 'Wildcards'
+	var wildcardsList []string
+	d.Wildcards.ElementsAs(context.Background(), &wildcardsList, false)
+
+	return &unbound.DNSBL{
+		Enabled:    tools.BoolToString(d.Enabled.ValueBool()),
+		SafeSearch: tools.BoolToString(d.SafeSearch.ValueBool()),
+		Type:       typeList,
+		Lists:      listsList,
+		Whitelists: whitelistsList,
+		Blocklists: blocklistsList,
+		Wildcards:  wildcardsList,
+		Address:    d.Address.ValueString(),
+		NXDomain:   tools.BoolToString(d.NXDomain.ValueBool()),
+	}, nil
+}
+
+func convertUnboundDNSBLStructToSchema(d *unbound.DNSBL) (*UnboundDNSBLResourceModel, error) {
+	return &UnboundDNSBLResourceModel{
+		Enabled:    types.BoolValue(tools.StringToBool(d.Enabled)),
+		SafeSearch: types.BoolValue(tools.StringToBool(d.SafeSearch)),
+		Type:       tools.StringSliceToSet(d.Type),
+		Lists:      tools.StringSliceToSet(d.Lists),
+		Whitelists: tools.StringSliceToSet(d.Whitelists),
+		Blocklists: tools.StringSliceToSet(d.Blocklists),
+		Wildcards:  tools.StringSliceToSet(d.Wildcards),
+		Address:    types.StringValue(d.Address),
+		NXDomain:   types.BoolValue(tools.StringToBool(d.NXDomain)),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+func NewUnboundDomainOverrideExclusiveResource() resource.Resource {
+	return &ExclusiveResource{kind: &exclusiveKind{
+		typeName: "unbound_domain_override",
+		noun:     "domain override",
+		plural:   "domain overrides",
+		list: func(ctx context.Context, c opnsense.Client) ([]exclusiveObject, error) {
+			rows, err := c.Unbound().SearchDomainOverrides(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var objects []exclusiveObject
+			for _, row := range rows {
+				objects = append(objects, exclusiveObject{Id: row.Id, Name: row.Domain})
+			}
+			return objects, nil
+		},
+		delete: func(ctx context.Context, c opnsense.Client, id string) error {
+			return c.Unbound().DeleteDomainOverride(ctx, id)
+		},
+	}}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+func NewUnboundHostAliasExclusiveResource() resource.Resource {
+	return &ExclusiveResource{kind: &exclusiveKind{
+		typeName: "unbound_host_alias",
+		noun:     "host alias",
+		plural:   "host aliases",
+		list: func(ctx context.Context, c opnsense.Client) ([]exclusiveObject, error) {
+			rows, err := c.Unbound().SearchHostAliases(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var objects []exclusiveObject
+			for _, row := range rows {
+				objects = append(objects, exclusiveObject{
+					Id:   row.Id,
+					Name: fmt.Sprintf("%s.%s", row.Hostname, row.Domain),
+				})
+			}
+			return objects, nil
+		},
+		delete: func(ctx context.Context, c opnsense.Client, id string) error {
+			return c.Unbound().DeleteHostAlias(ctx, id)
+		},
+	}}
+}
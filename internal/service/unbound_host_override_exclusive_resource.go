@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+func NewUnboundHostOverrideExclusiveResource() resource.Resource {
+	return &ExclusiveResource{kind: &exclusiveKind{
+		typeName: "unbound_host_override",
+		noun:     "host override",
+		plural:   "host overrides",
+		note:     " Host overrides managed by `opnsense_unbound_host_overrides` must be added to `managed_ids` using its `ids` attribute, otherwise they are deleted.",
+		list: func(ctx context.Context, c opnsense.Client) ([]exclusiveObject, error) {
+			rows, err := c.Unbound().SearchHostOverrides(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var objects []exclusiveObject
+			for _, row := range rows {
+				objects = append(objects, exclusiveObject{
+					Id:   row.Id,
+					Name: fmt.Sprintf("%s.%s", row.Hostname, row.Domain),
+				})
+			}
+			return objects, nil
+		},
+		delete: func(ctx context.Context, c opnsense.Client, id string) error {
+			return c.Unbound().DeleteHostOverride(ctx, id)
+		},
+	}}
+}
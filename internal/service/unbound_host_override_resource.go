@@ -6,16 +6,17 @@ import (
 	"fmt"
 	"github.com/browningluke/opnsense-go/pkg/api"
 	"github.com/browningluke/opnsense-go/pkg/errs"
-	"github.com/browningluke/opnsense-go/pkg/opnsense"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UnboundHostOverrideResource{}
 var _ resource.ResourceWithImportState = &UnboundHostOverrideResource{}
+var _ resource.ResourceWithValidateConfig = &UnboundHostOverrideResource{}
 
 func NewUnboundHostOverrideResource() resource.Resource {
 	return &UnboundHostOverrideResource{}
@@ -52,6 +53,19 @@ func (r *UnboundHostOverrideResource) Configure(ctx context.Context, req resourc
 	r.client = opnsense.NewClient(apiClient)
 }
 
+func (r *UnboundHostOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *UnboundHostOverrideResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateUnboundHostOverrideConfig(data)...)
+}
+
 func (r *UnboundHostOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *UnboundHostOverrideResourceModel
 
@@ -1,11 +1,12 @@
 package service
 
 import (
+	"fmt"
 	"github.com/browningluke/opnsense-go/pkg/api"
-	"github.com/browningluke/opnsense-go/pkg/unbound"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -15,6 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/netip"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
 	"terraform-provider-opnsense/internal/tools"
 )
 
@@ -30,12 +34,14 @@ type UnboundHostOverrideResourceModel struct {
 	MXPriority types.Int64  `tfsdk:"mx_priority"`
 	MXDomain   types.String `tfsdk:"mx_host"`
 
+	TXTData types.String `tfsdk:"txtdata"`
+
 	Id types.String `tfsdk:"id"`
 }
 
 func unboundHostOverrideResourceSchema() schema.Schema {
 	return schema.Schema{
-		MarkdownDescription: "Host overrides can be used to change DNS results from client queries or to add custom DNS records.",
+		MarkdownDescription: "Host overrides can be used to change DNS results from client queries or to add custom DNS records. OPNsense only supports `A`, `AAAA`, `MX` and `TXT` records in host overrides, use `opnsense_unbound_host_alias` to give a host override other names (similar to a `CNAME` record).",
 
 		Attributes: map[string]schema.Attribute{
 			"enabled": schema.BoolAttribute{
@@ -53,33 +59,34 @@ func unboundHostOverrideResourceSchema() schema.Schema {
 				Required:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`. Defaults to `A`.",
+				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`, `TXT`. `CNAME` records are not supported by OPNsense, use `opnsense_unbound_host_alias` instead. Defaults to `A`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("A"),
 				Validators: []validator.String{
-					stringvalidator.OneOf("A", "AAAA", "MX"),
+					stringvalidator.OneOf("A", "AAAA", "MX", "TXT"),
 				},
 			},
 			"server": schema.StringAttribute{
-				MarkdownDescription: "IP address of the host, e.g. 192.168.100.100 or fd00:abcd::1. Must be set when `type` is `A` or `AAAA`.",
+				MarkdownDescription: "IP address of the host, e.g. 192.168.100.100 or fd00:abcd::1. Must be an IPv4 address when `type` is `A`, and an IPv6 address when `type` is `AAAA`. Can only be set for these types.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
 			"mx_priority": schema.Int64Attribute{
-				MarkdownDescription: "Priority of MX record, e.g. 10. Must be set when `type` is `MX`.",
+				MarkdownDescription: "Priority of MX record, e.g. 10. Must be set when `type` is `MX`, and can only be set for this type.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(-1),
 				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
 					int64validator.AlsoRequires(path.Expressions{
 						path.MatchRoot("mx_host"),
 					}...),
 				},
 			},
 			"mx_host": schema.StringAttribute{
-				MarkdownDescription: "Host name of MX host, e.g. mail.example.com. Must be set when `type` is `MX`.",
+				MarkdownDescription: "Host name of MX host, e.g. mail.example.com. Must be set when `type` is `MX`, and can only be set for this type.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
@@ -89,6 +96,12 @@ func unboundHostOverrideResourceSchema() schema.Schema {
 					}...),
 				},
 			},
+			"txtdata": schema.StringAttribute{
+				MarkdownDescription: "Text of the TXT record, e.g. `v=spf1 mx -all`. Must be set when `type` is `TXT`, and can only be set for this type.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Optional description here for your reference (not parsed).",
 				Optional:            true,
@@ -130,7 +143,7 @@ func UnboundHostOverrideDataSourceSchema() dschema.Schema {
 				Computed:            true,
 			},
 			"type": dschema.StringAttribute{
-				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`.",
+				MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`, `TXT`.",
 				Computed:            true,
 			},
 			"server": dschema.StringAttribute{
@@ -145,6 +158,10 @@ func UnboundHostOverrideDataSourceSchema() dschema.Schema {
 				MarkdownDescription: "Host name of MX host, e.g. mail.example.com.",
 				Computed:            true,
 			},
+			"txtdata": dschema.StringAttribute{
+				MarkdownDescription: "Text of the TXT record.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -158,6 +175,7 @@ func convertUnboundHostOverrideSchemaToStruct(d *UnboundHostOverrideResourceMode
 		Server:      d.Server.ValueString(),
 		MXDomain:    d.MXDomain.ValueString(),
 		MXPriority:  tools.Int64ToStringNegative(d.MXPriority.ValueInt64()),
+		TXTData:     d.TXTData.ValueString(),
 		Description: d.Description.ValueString(),
 	}, nil
 }
@@ -171,6 +189,117 @@ func convertUnboundHostOverrideStructToSchema(d *unbound.HostOverride) (*Unbound
 		Server:      types.StringValue(d.Server),
 		MXPriority:  types.Int64Value(tools.StringToInt64(d.MXPriority)),
 		MXDomain:    types.StringValue(d.MXDomain),
+		TXTData:     types.StringValue(d.TXTData),
 		Description: tools.StringOrNull(d.Description),
 	}, nil
 }
+
+// unboundHostOverrideHostRegex matches host names, e.g. `mail.example.com`.
+var unboundHostOverrideHostRegex = regexp.MustCompile(`^([A-Za-z0-9_]([A-Za-z0-9_-]*[A-Za-z0-9_])?\.)*[A-Za-z0-9_]([A-Za-z0-9_-]*[A-Za-z0-9_])?\.?$`)
+
+// unboundHostOverrideValueError returns why value is not valid as the value
+// of a record of recordType (the server, MX host or text), or "" when it is.
+func unboundHostOverrideValueError(recordType string, value string) string {
+	switch recordType {
+	case "A":
+		if addr, err := netip.ParseAddr(value); err != nil || !addr.Is4() {
+			return fmt.Sprintf("must be an IPv4 address when type is A, got: %q", value)
+		}
+	case "AAAA":
+		if addr, err := netip.ParseAddr(value); err != nil || !addr.Is6() || addr.Is4In6() {
+			return fmt.Sprintf("must be an IPv6 address when type is AAAA, got: %q", value)
+		}
+	case "MX":
+		if !unboundHostOverrideHostRegex.MatchString(value) {
+			return fmt.Sprintf("must be a host name when type is MX, got: %q", value)
+		}
+	case "TXT":
+		if value == "" {
+			return "must not be empty when type is TXT"
+		}
+	}
+	return ""
+}
+
+// validateUnboundHostOverrideConfig ensures the attributes required by the
+// record type are set and valid, and that the attributes of other record
+// types are not set, since OPNsense only reports these when applying.
+func validateUnboundHostOverrideConfig(d *UnboundHostOverrideResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Type may not be known until apply
+	if d.Type.IsUnknown() {
+		return diags
+	}
+
+	// Unset strings are null in config (defaults only apply to the plan)
+	isUnset := func(s types.String) bool {
+		return s.IsNull() || (!s.IsUnknown() && s.ValueString() == "")
+	}
+	isSet := func(s types.String) bool {
+		return !s.IsUnknown() && !isUnset(s)
+	}
+
+	recordType := d.Type.ValueString()
+	if d.Type.IsNull() {
+		recordType = "A"
+	}
+
+	// Checks the value of the record type, unless it is not known until apply
+	checkValue := func(p path.Path, s types.String) {
+		if s.IsUnknown() {
+			return
+		}
+		if msg := unboundHostOverrideValueError(recordType, s.ValueString()); msg != "" {
+			diags.AddAttributeError(p, "Invalid Attribute Value",
+				fmt.Sprintf("Attribute %q %s", p.String(), msg))
+		}
+	}
+
+	// Rejects attributes of other record types, which OPNsense ignores
+	checkUnset := func(p path.Path, set bool, recordTypes string) {
+		if set {
+			diags.AddAttributeError(p, "Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %q can only be set when type is %s, got type %s.", p.String(), recordTypes, recordType))
+		}
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if isUnset(d.Server) {
+			diags.AddAttributeError(path.Root("server"), "Missing Attribute Configuration",
+				fmt.Sprintf("Attribute \"server\" must be set when type is %s.", recordType))
+		} else {
+			checkValue(path.Root("server"), d.Server)
+		}
+	case "MX":
+		if isUnset(d.MXDomain) {
+			diags.AddAttributeError(path.Root("mx_host"), "Missing Attribute Configuration",
+				"Attribute \"mx_host\" must be set when type is MX.")
+		} else {
+			checkValue(path.Root("mx_host"), d.MXDomain)
+		}
+		if d.MXPriority.IsNull() {
+			diags.AddAttributeError(path.Root("mx_priority"), "Missing Attribute Configuration",
+				"Attribute \"mx_priority\" must be set when type is MX.")
+		}
+	case "TXT":
+		if isUnset(d.TXTData) {
+			diags.AddAttributeError(path.Root("txtdata"), "Missing Attribute Configuration",
+				"Attribute \"txtdata\" must be set when type is TXT.")
+		}
+	}
+
+	if recordType != "A" && recordType != "AAAA" {
+		checkUnset(path.Root("server"), isSet(d.Server), "A or AAAA")
+	}
+	if recordType != "MX" {
+		checkUnset(path.Root("mx_host"), isSet(d.MXDomain), "MX")
+		checkUnset(path.Root("mx_priority"), !d.MXPriority.IsNull() && !d.MXPriority.IsUnknown() && d.MXPriority.ValueInt64() != -1, "MX")
+	}
+	if recordType != "TXT" {
+		checkUnset(path.Root("txtdata"), isSet(d.TXTData), "TXT")
+	}
+
+	return diags
+}
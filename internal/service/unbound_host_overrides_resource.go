@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UnboundHostOverridesResource{}
+var _ resource.ResourceWithImportState = &UnboundHostOverridesResource{}
+var _ resource.ResourceWithValidateConfig = &UnboundHostOverridesResource{}
+
+func NewUnboundHostOverridesResource() resource.Resource {
+	return &UnboundHostOverridesResource{}
+}
+
+// UnboundHostOverridesResource defines the resource implementation.
+type UnboundHostOverridesResource struct {
+	client opnsense.Client
+}
+
+func (r *UnboundHostOverridesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_host_overrides"
+}
+
+func (r *UnboundHostOverridesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = unboundHostOverridesResourceSchema()
+}
+
+func (r *UnboundHostOverridesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = opnsense.NewClient(apiClient)
+}
+
+func (r *UnboundHostOverridesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *UnboundHostOverridesResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateUnboundHostOverridesConfig(data)...)
+}
+
+// getManagedHostOverrides returns the host overrides tagged with tag, by FQDN.
+// Duplicate host overrides for the same FQDN are returned separately, so they
+// can be removed.
+func (r *UnboundHostOverridesResource) getManagedHostOverrides(ctx context.Context, tag string) (map[string]unbound.HostOverrideRow, []string, error) {
+	rows, err := r.client.Unbound().SearchHostOverrides(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	managed := map[string]unbound.HostOverrideRow{}
+	var duplicates []string
+	for _, row := range rows {
+		if row.Description != tag {
+			continue
+		}
+
+		fqdn := fmt.Sprintf("%s.%s", row.Hostname, row.Domain)
+		if _, ok := managed[fqdn]; ok {
+			duplicates = append(duplicates, row.Id)
+			continue
+		}
+		managed[fqdn] = row
+	}
+
+	return managed, duplicates, nil
+}
+
+// getManagedHostOverrideIds returns the UUIDs of the host overrides tagged
+// with tag.
+func (r *UnboundHostOverridesResource) getManagedHostOverrideIds(ctx context.Context, tag string) (types.Set, error) {
+	managed, _, err := r.getManagedHostOverrides(ctx, tag)
+	if err != nil {
+		return types.SetNull(types.StringType), err
+	}
+
+	return unboundHostOverridesIds(managed), nil
+}
+
+// unboundHostOverridesIds returns the UUIDs of the host overrides as a set.
+func unboundHostOverridesIds(managed map[string]unbound.HostOverrideRow) types.Set {
+	var ids []string
+	for _, row := range managed {
+		ids = append(ids, row.Id)
+	}
+	return tools.StringSliceToSet(ids)
+}
+
+// reconcile diffs the planned records against the tagged host overrides in
+// OPNsense, and applies the differences in one batch.
+func (r *UnboundHostOverridesResource) reconcile(ctx context.Context, data *UnboundHostOverridesResourceModel) error {
+	desired, err := convertUnboundHostOverridesSchemaToStruct(data)
+	if err != nil {
+		return err
+	}
+
+	managed, duplicates, err := r.getManagedHostOverrides(ctx, data.Tag.ValueString())
+	if err != nil {
+		return err
+	}
+
+	batch := &unbound.HostOverrideBatch{
+		Update: map[string]*unbound.HostOverride{},
+		Delete: duplicates,
+	}
+	for fqdn, override := range desired {
+		row, ok := managed[fqdn]
+		if !ok {
+			batch.Add = append(batch.Add, override)
+		} else if !unboundHostOverridesEqual(override, row.HostOverride()) {
+			batch.Update[row.Id] = override
+		}
+	}
+	for fqdn, row := range managed {
+		if _, ok := desired[fqdn]; !ok {
+			batch.Delete = append(batch.Delete, row.Id)
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("reconciling host overrides: %d to add, %d to update, %d to delete",
+		len(batch.Add), len(batch.Update), len(batch.Delete)))
+
+	return r.client.Unbound().ApplyHostOverrideBatch(ctx, batch)
+}
+
+func (r *UnboundHostOverridesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *UnboundHostOverridesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tag new resource with its tag
+	data.Id = data.Tag
+
+	// Add host overrides to unbound
+	err := r.reconcile(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to create host overrides, got error: %s", err))
+		return
+	}
+
+	// Get the UUIDs of the host overrides, which are assigned by OPNsense
+	data.Ids, err = r.getManagedHostOverrideIds(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host overrides, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UnboundHostOverridesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *UnboundHostOverridesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get tagged host overrides from OPNsense unbound API
+	managed, _, err := r.getManagedHostOverrides(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host overrides, got error: %s", err))
+		return
+	}
+
+	overrides := map[string]*unbound.HostOverride{}
+	for fqdn, row := range managed {
+		overrides[fqdn] = row.HostOverride()
+	}
+
+	// Convert OPNsense structs to TF schema
+	resourceModel, err := convertUnboundHostOverridesStructToSchema(data.Id.ValueString(), overrides)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host overrides, got error: %s", err))
+		return
+	}
+
+	// IDs cannot be added by convert... func, have to add here
+	resourceModel.Ids = unboundHostOverridesIds(managed)
+	resourceModel.Id = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
+
+func (r *UnboundHostOverridesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *UnboundHostOverridesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update host overrides in unbound
+	err := r.reconcile(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to update host overrides, got error: %s", err))
+		return
+	}
+
+	// Get the UUIDs of the host overrides, which are assigned by OPNsense
+	data.Ids, err = r.getManagedHostOverrideIds(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host overrides, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UnboundHostOverridesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *UnboundHostOverridesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconciling without records removes all tagged host overrides
+	data.Records = map[string]unboundHostOverridesRecord{}
+	err := r.reconcile(ctx, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to delete host overrides, got error: %s", err))
+		return
+	}
+}
+
+func (r *UnboundHostOverridesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type unboundHostOverridesRecord struct {
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	MXPriority types.Int64  `tfsdk:"mx_priority"`
+}
+
+// UnboundHostOverridesResourceModel describes the resource data model.
+type UnboundHostOverridesResourceModel struct {
+	Tag     types.String                          `tfsdk:"tag"`
+	Records map[string]unboundHostOverridesRecord `tfsdk:"records"`
+
+	Ids types.Set    `tfsdk:"ids"`
+	Id  types.String `tfsdk:"id"`
+}
+
+func unboundHostOverridesResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages a set of host overrides at once, which is faster than one `opnsense_unbound_host_override` per record when managing many records. Host overrides managed by this resource are identified by their description, which is set to `tag`. Any other host override with that description is removed, and Unbound is only reconfigured once per apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Tag identifying the host overrides managed by this resource. It is used as the description of each host override, so must be unique across resources and must not be used by other host overrides.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.MapNestedAttribute{
+				MarkdownDescription: "Map of fully qualified domain names to their record, e.g. `app.example.com`. The first label is used as the hostname and the remainder as the domain, use `*` as first label to create a wildcard entry.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(
+						unboundHostOverridesFQDNRegex, "must be a fully qualified domain name, e.g. `app.example.com`",
+					)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Enable the override for this host. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`, `TXT`. Defaults to `A`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("A"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("A", "AAAA", "MX", "TXT"),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the record. The IPv4 address of the host when `type` is `A`, its IPv6 address when `type` is `AAAA`, the host name of the MX host when `type` is `MX`, and the text when `type` is `TXT`. Values are checked against `type` when planning.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"mx_priority": schema.Int64Attribute{
+							MarkdownDescription: "Priority of MX record, e.g. 10. Must be set when `type` is `MX`, and can only be set for this type.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(-1),
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+					},
+				},
+			},
+			"ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "UUIDs of the host overrides managed by this resource. Add them to the `managed_ids` of `opnsense_unbound_host_override_exclusive`, so that it does not delete them.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the resource, this is the same as `tag`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// unboundHostOverridesFQDNRegex matches names with at least two labels.
+var unboundHostOverridesFQDNRegex = regexp.MustCompile(`^(\*|[A-Za-z0-9_-]+)(\.[A-Za-z0-9_-]+)+$`)
+
+// validateUnboundHostOverridesConfig ensures each record sets the attributes
+// required by its record type, and that its value is valid for that type, so
+// that an invalid record does not fail the apply after others were written.
+func validateUnboundHostOverridesConfig(d *UnboundHostOverridesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for fqdn, record := range d.Records {
+		// Type may not be known until apply
+		if record.Type.IsUnknown() {
+			continue
+		}
+
+		recordType := record.Type.ValueString()
+		if record.Type.IsNull() {
+			recordType = "A"
+		}
+
+		recordPath := path.Root("records").AtMapKey(fqdn)
+
+		if recordType == "MX" && record.MXPriority.IsNull() {
+			diags.AddAttributeError(recordPath.AtName("mx_priority"),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("Attribute \"mx_priority\" must be set for %s, since type is MX.", fqdn))
+		}
+		if recordType != "MX" && !record.MXPriority.IsNull() && !record.MXPriority.IsUnknown() && record.MXPriority.ValueInt64() != -1 {
+			diags.AddAttributeError(recordPath.AtName("mx_priority"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute \"mx_priority\" can only be set for %s when type is MX, got type %s.", fqdn, recordType))
+		}
+
+		if !record.Value.IsNull() && !record.Value.IsUnknown() {
+			if msg := unboundHostOverrideValueError(recordType, record.Value.ValueString()); msg != "" {
+				diags.AddAttributeError(recordPath.AtName("value"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Attribute \"value\" of %s %s", fqdn, msg))
+			}
+		}
+	}
+
+	return diags
+}
+
+// convertUnboundHostOverridesRecordToModel converts a record into the model of
+// a single host override, so it can be converted like one.
+func convertUnboundHostOverridesRecordToModel(tag string, fqdn string, r unboundHostOverridesRecord) *UnboundHostOverrideResourceModel {
+	hostname, domain, _ := strings.Cut(fqdn, ".")
+
+	model := &UnboundHostOverrideResourceModel{
+		Enabled:     r.Enabled,
+		Hostname:    types.StringValue(hostname),
+		Domain:      types.StringValue(domain),
+		Type:        r.Type,
+		Server:      types.StringValue(""),
+		MXPriority:  types.Int64Value(-1),
+		MXDomain:    types.StringValue(""),
+		TXTData:     types.StringValue(""),
+		Description: types.StringValue(tag),
+	}
+
+	switch r.Type.ValueString() {
+	case "MX":
+		model.MXPriority = r.MXPriority
+		model.MXDomain = r.Value
+	case "TXT":
+		model.TXTData = r.Value
+	default:
+		model.Server = r.Value
+	}
+
+	return model
+}
+
+func convertUnboundHostOverridesSchemaToStruct(d *UnboundHostOverridesResourceModel) (map[string]*unbound.HostOverride, error) {
+	overrides := map[string]*unbound.HostOverride{}
+	for fqdn, record := range d.Records {
+		override, err := convertUnboundHostOverrideSchemaToStruct(
+			convertUnboundHostOverridesRecordToModel(d.Tag.ValueString(), fqdn, record),
+		)
+		if err != nil {
+			return nil, err
+		}
+		overrides[fqdn] = override
+	}
+	return overrides, nil
+}
+
+func convertUnboundHostOverridesStructToSchema(tag string, d map[string]*unbound.HostOverride) (*UnboundHostOverridesResourceModel, error) {
+	model := &UnboundHostOverridesResourceModel{
+		Tag:     types.StringValue(tag),
+		Records: map[string]unboundHostOverridesRecord{},
+	}
+
+	for fqdn, override := range d {
+		record := unboundHostOverridesRecord{
+			Enabled:    types.BoolValue(tools.StringToBool(override.Enabled)),
+			Type:       types.StringValue(override.Type.String()),
+			Value:      types.StringValue(override.Server),
+			MXPriority: types.Int64Value(-1),
+		}
+
+		switch override.Type.String() {
+		case "MX":
+			record.Value = types.StringValue(override.MXDomain)
+			record.MXPriority = types.Int64Value(tools.StringToInt64(override.MXPriority))
+		case "TXT":
+			record.Value = types.StringValue(override.TXTData)
+		}
+
+		model.Records[fqdn] = record
+	}
+
+	return model, nil
+}
+
+// unboundHostOverridesEqual compares the fields of a host override that are
+// managed by opnsense_unbound_host_overrides.
+func unboundHostOverridesEqual(a *unbound.HostOverride, b *unbound.HostOverride) bool {
+	return a.Enabled == b.Enabled &&
+		a.Type.String() == b.Type.String() &&
+		a.Server == b.Server &&
+		a.MXPriority == b.MXPriority &&
+		a.MXDomain == b.MXDomain &&
+		a.TXTData == b.TXTData
+}
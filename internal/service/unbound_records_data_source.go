@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/browningluke/opnsense-go/pkg/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UnboundRecordsDataSource{}
+
+func NewUnboundRecordsDataSource() datasource.DataSource {
+	return &UnboundRecordsDataSource{}
+}
+
+// UnboundRecordsDataSource defines the data source implementation.
+type UnboundRecordsDataSource struct {
+	client opnsense.Client
+}
+
+func (d *UnboundRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unbound_records"
+}
+
+func (d *UnboundRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = UnboundRecordsDataSourceSchema()
+}
+
+func (d *UnboundRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	apiClient, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = opnsense.NewClient(apiClient)
+}
+
+func (d *UnboundRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get host overrides from OPNsense API
+	overrideRows, err := d.client.Unbound().SearchHostOverrides(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host overrides, got error: %s", err))
+		return
+	}
+
+	overrides := map[string]*unbound.HostOverride{}
+	for _, row := range overrideRows {
+		overrides[row.Id] = row.HostOverride()
+	}
+
+	// Get host aliases from OPNsense API. Search results do not contain the
+	// UUID of the host override, so each alias has to be read.
+	aliasRows, err := d.client.Unbound().SearchHostAliases(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read host aliases, got error: %s", err))
+		return
+	}
+
+	aliases := map[string]*unbound.HostAlias{}
+	for _, row := range aliasRows {
+		alias, err := d.client.Unbound().GetHostAlias(ctx, row.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to read host alias %s, got error: %s", row.Id, err))
+			return
+		}
+		aliases[row.Id] = alias
+	}
+
+	// Convert OPNsense structs to TF schema
+	resourceModel, err := convertUnboundRecordsStructToSchema(overrides, aliases)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to read records, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
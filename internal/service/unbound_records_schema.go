@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"sort"
+	"terraform-provider-opnsense/internal/opnsense/unbound"
+	"terraform-provider-opnsense/internal/tools"
+)
+
+type unboundRecord struct {
+	FQDN    types.String `tfsdk:"fqdn"`
+	Type    types.String `tfsdk:"type"`
+	Value   types.String `tfsdk:"value"`
+	Source  types.String `tfsdk:"source"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// UnboundRecordsDataSourceModel describes the data source data model.
+type UnboundRecordsDataSourceModel struct {
+	Records   []unboundRecord `tfsdk:"records"`
+	Conflicts types.Set       `tfsdk:"conflicts"`
+}
+
+func UnboundRecordsDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "Lists the DNS records resolved by Unbound from host overrides and their host aliases. Host aliases resolve to the record of their host override, so they are listed with the type and value of that host override.",
+
+		Attributes: map[string]dschema.Attribute{
+			"records": dschema.ListNestedAttribute{
+				MarkdownDescription: "List of records, sorted by `fqdn`.",
+				Computed:            true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"fqdn": dschema.StringAttribute{
+							MarkdownDescription: "Fully qualified domain name of the record, e.g. `app.example.com`. Wildcard entries start with `*`.",
+							Computed:            true,
+						},
+						"type": dschema.StringAttribute{
+							MarkdownDescription: "Type of resource record. Available values: `A`, `AAAA`, `MX`, `TXT`.",
+							Computed:            true,
+						},
+						"value": dschema.StringAttribute{
+							MarkdownDescription: "Value of the record. The IP address of the host when `type` is `A` or `AAAA`, the priority and host name of the MX host when `type` is `MX` (e.g. `10 mail.example.com`), and the text when `type` is `TXT`.",
+							Computed:            true,
+						},
+						"source": dschema.StringAttribute{
+							MarkdownDescription: "UUID of the host override or host alias that defines the record.",
+							Computed:            true,
+						},
+						"enabled": dschema.BoolAttribute{
+							MarkdownDescription: "Whether the record is resolved. Records of host aliases are only enabled when their host override is enabled too.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"conflicts": dschema.SetAttribute{
+				MarkdownDescription: "Set of FQDNs with enabled `A` or `AAAA` records that resolve to more than one address of the same type.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func unboundRecordFQDN(hostname string, domain string) string {
+	if hostname == "" {
+		return domain
+	}
+	return fmt.Sprintf("%s.%s", hostname, domain)
+}
+
+func unboundRecordValue(d *unbound.HostOverride) string {
+	switch d.Type.String() {
+	case "MX":
+		return fmt.Sprintf("%s %s", d.MXPriority, d.MXDomain)
+	case "TXT":
+		return d.TXTData
+	default:
+		return d.Server
+	}
+}
+
+// convertUnboundRecordsStructToSchema flattens the host overrides and host
+// aliases (both by UUID) into records. Aliases of unknown host overrides
+// are skipped, since they do not resolve.
+func convertUnboundRecordsStructToSchema(overrides map[string]*unbound.HostOverride, aliases map[string]*unbound.HostAlias) (*UnboundRecordsDataSourceModel, error) {
+	model := &UnboundRecordsDataSourceModel{
+		Records: []unboundRecord{},
+	}
+
+	for id, override := range overrides {
+		model.Records = append(model.Records, unboundRecord{
+			FQDN:    types.StringValue(unboundRecordFQDN(override.Hostname, override.Domain)),
+			Type:    types.StringValue(override.Type.String()),
+			Value:   types.StringValue(unboundRecordValue(override)),
+			Source:  types.StringValue(id),
+			Enabled: types.BoolValue(tools.StringToBool(override.Enabled)),
+		})
+	}
+
+	for id, alias := range aliases {
+		override, ok := overrides[alias.Host.String()]
+		if !ok {
+			continue
+		}
+
+		model.Records = append(model.Records, unboundRecord{
+			FQDN:    types.StringValue(unboundRecordFQDN(alias.Hostname, alias.Domain)),
+			Type:    types.StringValue(override.Type.String()),
+			Value:   types.StringValue(unboundRecordValue(override)),
+			Source:  types.StringValue(id),
+			Enabled: types.BoolValue(tools.StringToBool(alias.Enabled) && tools.StringToBool(override.Enabled)),
+		})
+	}
+
+	// Sort records, so the list is stable between reads
+	sort.Slice(model.Records, func(i, j int) bool {
+		a, b := model.Records[i], model.Records[j]
+		if a.FQDN.ValueString() != b.FQDN.ValueString() {
+			return a.FQDN.ValueString() < b.FQDN.ValueString()
+		}
+		if a.Type.ValueString() != b.Type.ValueString() {
+			return a.Type.ValueString() < b.Type.ValueString()
+		}
+		return a.Source.ValueString() < b.Source.ValueString()
+	})
+
+	// Find names that resolve to different addresses
+	addresses := map[string]map[string]bool{}
+	conflicts := map[string]bool{}
+	for _, record := range model.Records {
+		if !record.Enabled.ValueBool() {
+			continue
+		}
+		if record.Type.ValueString() != "A" && record.Type.ValueString() != "AAAA" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", record.FQDN.ValueString(), record.Type.ValueString())
+		if addresses[key] == nil {
+			addresses[key] = map[string]bool{}
+		}
+		addresses[key][record.Value.ValueString()] = true
+
+		if len(addresses[key]) > 1 {
+			conflicts[record.FQDN.ValueString()] = true
+		}
+	}
+
+	var conflictsList []attr.Value
+	for fqdn := range conflicts {
+		conflictsList = append(conflictsList, basetypes.NewStringValue(fqdn))
+	}
+	conflictsTypeList, _ := types.SetValue(types.StringType, conflictsList)
+	model.Conflicts = conflictsTypeList
+
+	return model, nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-opnsense/internal/opnsense/wireguard"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// WireguardPeerResourceModel describes the resource data model.
+type WireguardPeerResourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Name         types.String `tfsdk:"name"`
+	PublicKey    types.String `tfsdk:"public_key"`
+	PresharedKey types.String `tfsdk:"preshared_key"`
+	AllowedIPs   types.Set    `tfsdk:"allowed_ips"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	EndpointPort types.Int64  `tfsdk:"endpoint_port"`
+	Keepalive    types.Int64  `tfsdk:"keepalive"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func wireguardPeerResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "WireGuard peers are the remote ends allowed to connect to a server. Link peers to a server with the `peers` attribute of `opnsense_wireguard_server`.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this peer. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the peer.",
+				Required:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded public key of the peer.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(wireguardKeyRegex, "must be a base64 encoded WireGuard key"),
+				},
+			},
+			"preshared_key": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded pre-shared key, for an additional layer of symmetric encryption.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(wireguardKeyRegex, "must be a base64 encoded WireGuard key"),
+				},
+			},
+			"allowed_ips": schema.SetAttribute{
+				MarkdownDescription: "Networks, in CIDR notation, the peer is allowed to send traffic from, and that are routed to it, e.g. `10.10.10.2/32`. Must specify at least 1.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Hostname or IP address of the peer. Leave unset for peers that connect to this firewall, e.g. road warriors.",
+				Optional:            true,
+			},
+			"endpoint_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the peer. Set to `-1` to use the default of 51820. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"keepalive": schema.Int64Attribute{
+				MarkdownDescription: "Interval, in seconds, to send keepalive packets at. Set to `-1` to disable. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the peer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func WireguardPeerDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "WireGuard peers are the remote ends allowed to connect to a server.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this peer is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the peer.",
+				Computed:            true,
+			},
+			"public_key": dschema.StringAttribute{
+				MarkdownDescription: "Base64 encoded public key of the peer.",
+				Computed:            true,
+			},
+			"preshared_key": dschema.StringAttribute{
+				MarkdownDescription: "Base64 encoded pre-shared key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"allowed_ips": dschema.SetAttribute{
+				MarkdownDescription: "Networks the peer is allowed to send traffic from.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"endpoint": dschema.StringAttribute{
+				MarkdownDescription: "Hostname or IP address of the peer.",
+				Computed:            true,
+			},
+			"endpoint_port": dschema.Int64Attribute{
+				MarkdownDescription: "Port of the peer. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"keepalive": dschema.Int64Attribute{
+				MarkdownDescription: "Interval, in seconds, to send keepalive packets at. `-1` if disabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func convertWireguardPeerSchemaToStruct(d *WireguardPeerResourceModel) (*wireguard.Peer, error) {
+	// Parse 'AllowedIPs'
+	var allowedIPList []string
+	d.AllowedIPs.ElementsAs(context.Background(), &allowedIPList, false)
+
+	return &wireguard.Peer{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Name:          d.Name.ValueString(),
+		PublicKey:     d.PublicKey.ValueString(),
+		PSK:           d.PresharedKey.ValueString(),
+		TunnelAddress: allowedIPList,
+		ServerAddress: d.Endpoint.ValueString(),
+		ServerPort:    tools.Int64ToStringNegative(d.EndpointPort.ValueInt64()),
+		Keepalive:     tools.Int64ToStringNegative(d.Keepalive.ValueInt64()),
+	}, nil
+}
+
+func convertWireguardPeerStructToSchema(d *wireguard.Peer) (*WireguardPeerResourceModel, error) {
+	model := &WireguardPeerResourceModel{
+		Enabled:      types.BoolValue(tools.StringToBool(d.Enabled)),
+		Name:         types.StringValue(d.Name),
+		PublicKey:    types.StringValue(d.PublicKey),
+		PresharedKey: tools.StringOrNull(d.PSK),
+		AllowedIPs:   tools.StringSliceToSet(d.TunnelAddress),
+		Endpoint:     tools.StringOrNull(d.ServerAddress),
+		EndpointPort: types.Int64Value(-1),
+		Keepalive:    types.Int64Value(-1),
+	}
+
+	if d.ServerPort != "" {
+		model.EndpointPort = types.Int64Value(tools.StringToInt64(d.ServerPort))
+	}
+	if d.Keepalive != "" {
+		model.Keepalive = types.Int64Value(tools.StringToInt64(d.Keepalive))
+	}
+
+	return model, nil
+}
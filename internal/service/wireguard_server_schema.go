@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-opnsense/internal/opnsense/wireguard"
+	"terraform-provider-opnsense/internal/tools"
+	"terraform-provider-opnsense/internal/validators"
+)
+
+// wireguardKeyRegex matches a base64 encoded 32 byte key.
+var wireguardKeyRegex = regexp.MustCompile(`^[A-Za-z0-9+/]{42}[AEIMQUYcgkosw048]=$`)
+
+// WireguardServerResourceModel describes the resource data model.
+type WireguardServerResourceModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Name            types.String `tfsdk:"name"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	ListenPort      types.Int64  `tfsdk:"listen_port"`
+	TunnelAddresses types.Set    `tfsdk:"tunnel_addresses"`
+	MTU             types.Int64  `tfsdk:"mtu"`
+	DNS             types.List   `tfsdk:"dns"`
+	DisableRoutes   types.Bool   `tfsdk:"disable_routes"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Peers           types.Set    `tfsdk:"peers"`
+
+	Id types.String `tfsdk:"id"`
+}
+
+func wireguardServerResourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "WireGuard servers are the local instances (interfaces) that peers connect to. When no `private_key` is set, a key pair is generated by OPNsense.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this server. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the server.",
+				Required:            true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded private key of the server. Generated by OPNsense when not set.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(wireguardKeyRegex, "must be a base64 encoded WireGuard key"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded public key of the server, to configure on the remote side.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"listen_port": schema.Int64Attribute{
+				MarkdownDescription: "Port to listen on. Set to `-1` to pick the next free port starting at 51820. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"tunnel_addresses": schema.SetAttribute{
+				MarkdownDescription: "Addresses of the server inside the tunnel, in CIDR notation, e.g. `10.10.10.1/24`. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.IsCIDR()),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU of the interface. Set to `-1` to use the default of 1420. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"dns": schema.ListAttribute{
+				MarkdownDescription: "DNS servers to use while the tunnel is up, in order of preference. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(tools.EmptyListValue()),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IsIP()),
+				},
+			},
+			"disable_routes": schema.BoolAttribute{
+				MarkdownDescription: "Do not add routes for the allowed IPs of the peers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Gateway IP address inside the tunnel, only used when `disable_routes` is `true`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsIP(),
+				},
+			},
+			"peers": schema.SetAttribute{
+				MarkdownDescription: "UUIDs of the peers (see `opnsense_wireguard_peer`) allowed to connect to this server. Defaults to `[]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(tools.EmptySetValue()),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "UUID of the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func WireguardServerDataSourceSchema() dschema.Schema {
+	return dschema.Schema{
+		MarkdownDescription: "WireGuard servers are the local instances (interfaces) that peers connect to.",
+
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				MarkdownDescription: "UUID of the resource.",
+				Required:            true,
+			},
+			"enabled": dschema.BoolAttribute{
+				MarkdownDescription: "Whether this server is enabled.",
+				Computed:            true,
+			},
+			"name": dschema.StringAttribute{
+				MarkdownDescription: "Name of the server.",
+				Computed:            true,
+			},
+			"private_key": dschema.StringAttribute{
+				MarkdownDescription: "Base64 encoded private key of the server.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"public_key": dschema.StringAttribute{
+				MarkdownDescription: "Base64 encoded public key of the server.",
+				Computed:            true,
+			},
+			"listen_port": dschema.Int64Attribute{
+				MarkdownDescription: "Port the server listens on. `-1` if picked automatically.",
+				Computed:            true,
+			},
+			"tunnel_addresses": dschema.SetAttribute{
+				MarkdownDescription: "Addresses of the server inside the tunnel.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"mtu": dschema.Int64Attribute{
+				MarkdownDescription: "MTU of the interface. `-1` if the default is used.",
+				Computed:            true,
+			},
+			"dns": dschema.ListAttribute{
+				MarkdownDescription: "DNS servers used while the tunnel is up.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"disable_routes": dschema.BoolAttribute{
+				MarkdownDescription: "Whether routes for the allowed IPs of the peers are not added.",
+				Computed:            true,
+			},
+			"gateway": dschema.StringAttribute{
+				MarkdownDescription: "Gateway IP address inside the tunnel.",
+				Computed:            true,
+			},
+			"peers": dschema.SetAttribute{
+				MarkdownDescription: "UUIDs of the peers allowed to connect to this server.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func convertWireguardServerSchemaToStruct(d *WireguardServerResourceModel) (*wireguard.Server, error) {
+	// Parse 'TunnelAddresses'
+	var tunnelAddressList []string
+	d.TunnelAddresses.ElementsAs(context.Background(), &tunnelAddressList, false)
+
+	// Parse 'DNS'
+	var dnsList []string
+	d.DNS.ElementsAs(context.Background(), &dnsList, false)
+
+	// Parse 'Peers'
+	var peerList []string
+	d.Peers.ElementsAs(context.Background(), &peerList, false)
+
+	return &wireguard.Server{
+		Enabled:       tools.BoolToString(d.Enabled.ValueBool()),
+		Name:          d.Name.ValueString(),
+		PrivateKey:    d.PrivateKey.ValueString(),
+		PublicKey:     d.PublicKey.ValueString(),
+		Port:          tools.Int64ToStringNegative(d.ListenPort.ValueInt64()),
+		MTU:           tools.Int64ToStringNegative(d.MTU.ValueInt64()),
+		DNS:           dnsList,
+		TunnelAddress: tunnelAddressList,
+		DisableRoutes: tools.BoolToString(d.DisableRoutes.ValueBool()),
+		Gateway:       d.Gateway.ValueString(),
+		Peers:         peerList,
+	}, nil
+}
+
+func convertWireguardServerStructToSchema(d *wireguard.Server) (*WireguardServerResourceModel, error) {
+	model := &WireguardServerResourceModel{
+		Enabled:         types.BoolValue(tools.StringToBool(d.Enabled)),
+		Name:            types.StringValue(d.Name),
+		PrivateKey:      types.StringValue(d.PrivateKey),
+		PublicKey:       types.StringValue(d.PublicKey),
+		ListenPort:      types.Int64Value(-1),
+		TunnelAddresses: tools.StringSliceToSet(d.TunnelAddress),
+		MTU:             types.Int64Value(-1),
+		DNS:             tools.StringSliceToList(d.DNS),
+		DisableRoutes:   types.BoolValue(tools.StringToBool(d.DisableRoutes)),
+		Gateway:         tools.StringOrNull(d.Gateway),
+		Peers:           tools.StringSliceToSet(d.Peers),
+	}
+
+	if d.Port != "" {
+		model.ListenPort = types.Int64Value(tools.StringToInt64(d.Port))
+	}
+	if d.MTU != "" {
+		model.MTU = types.Int64Value(tools.StringToInt64(d.MTU))
+	}
+
+	return model, nil
+}
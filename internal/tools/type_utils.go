@@ -89,3 +89,59 @@ func EmptySetValue() types.Set {
 	sv, _ := types.SetValue(types.StringType, []attr.Value{})
 	return sv
 }
+
+// StringSliceToSet converts a list of strings from the OPNsense API into a set,
+// skipping empty strings (which the API may include in lists).
+func StringSliceToSet(l []string) types.Set {
+	var list []attr.Value
+	for _, i := range l {
+		if i == "" {
+			continue
+		}
+		list = append(list, types.StringValue(i))
+	}
+	sv, _ := types.SetValue(types.StringType, list)
+	return sv
+}
+
+// Lists
+
+func EmptyListValue() types.List {
+	lv, _ := types.ListValue(types.StringType, []attr.Value{})
+	return lv
+}
+
+// StringSliceToList converts a list of strings from the OPNsense API into a list,
+// skipping empty strings (which the API may include in lists).
+func StringSliceToList(l []string) types.List {
+	var list []attr.Value
+	for _, i := range l {
+		if i == "" {
+			continue
+		}
+		list = append(list, types.StringValue(i))
+	}
+	lv, _ := types.ListValue(types.StringType, list)
+	return lv
+}
+
+// Maps
+
+func EmptyMapValue() types.Map {
+	mv, _ := types.MapValue(types.StringType, map[string]attr.Value{})
+	return mv
+}
+
+// StringMapToMap converts a map of strings from the OPNsense API into a map,
+// skipping empty values (which the API returns for unset fields).
+func StringMapToMap(m map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for k, v := range m {
+		if v == "" {
+			continue
+		}
+		elements[k] = types.StringValue(v)
+	}
+	mv, _ := types.MapValue(types.StringType, elements)
+	return mv
+}
@@ -0,0 +1,207 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	aliasNameRegex    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,31}$`)
+	aliasHostRegex    = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?\.)*[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?$`)
+	aliasCountryRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+	aliasMACRegex     = regexp.MustCompile(`^([0-9A-Fa-f]{2}[:-]){2,5}[0-9A-Fa-f]{2}$`)
+)
+
+// aliasContentType describes the entries accepted in the content of an alias
+// type.
+type aliasContentType struct {
+	description string
+	valid       func(entry string) bool
+}
+
+// aliasContentTypes are the alias types whose content is checked. Host and
+// network aliases can exclude entries with a `!` prefix, and can be nested
+// by name, like port aliases.
+var aliasContentTypes = map[string]aliasContentType{
+	"host": {
+		description: "IP addresses, IP ranges, hostnames or alias names",
+		valid: func(entry string) bool {
+			entry = strings.TrimPrefix(entry, "!")
+			return isIP(entry) || isIPRange(entry) || aliasHostRegex.MatchString(entry) || aliasNameRegex.MatchString(entry)
+		},
+	},
+	"network": {
+		description: "networks in CIDR notation, IP addresses or alias names",
+		valid: func(entry string) bool {
+			entry = strings.TrimPrefix(entry, "!")
+			_, _, err := net.ParseCIDR(entry)
+			return err == nil || isIP(entry) || aliasNameRegex.MatchString(entry)
+		},
+	},
+	"port": {
+		description: "ports, port ranges (e.g. `8000:8080`) or alias names",
+		valid: func(entry string) bool {
+			if from, to, ok := strings.Cut(entry, ":"); ok {
+				return isPort(from) && isPort(to) && portNumber(from) <= portNumber(to)
+			}
+			return isPort(entry) || aliasNameRegex.MatchString(entry)
+		},
+	},
+	"geoip": {
+		description: "ISO 3166-1 alpha-2 country codes (e.g. `FR`)",
+		valid:       aliasCountryRegex.MatchString,
+	},
+	"mac": {
+		description: "MAC addresses, or their first octets (e.g. `00:11:22`)",
+		valid:       aliasMACRegex.MatchString,
+	},
+	"asn": {
+		description: "AS numbers (e.g. `13335`)",
+		valid: func(entry string) bool {
+			asn, err := strconv.ParseUint(entry, 10, 32)
+			return err == nil && asn > 0
+		},
+	},
+	"url": {
+		description: "HTTP(S) or FTP URLs",
+		valid:       isURL,
+	},
+	"urltable": {
+		description: "HTTP(S) or FTP URLs",
+		valid:       isURL,
+	},
+	"networkgroup": {
+		description: "alias names or interface networks (e.g. `__lan_network`)",
+		valid:       aliasNameRegex.MatchString,
+	},
+	"external": {
+		description: "no entries, since their content is managed at runtime",
+		valid:       func(entry string) bool { return false },
+	},
+}
+
+// InvalidFirewallAliasContent returns the entries of content that are not valid for
+// an alias of aliasType, along with a description of the valid entries.
+// Types without constraints accept any entry.
+func InvalidFirewallAliasContent(aliasType string, content []string) ([]string, string) {
+	contentType, ok := aliasContentTypes[aliasType]
+	if !ok {
+		return nil, ""
+	}
+
+	var invalid []string
+	for _, entry := range content {
+		if !contentType.valid(entry) {
+			invalid = append(invalid, entry)
+		}
+	}
+	return invalid, contentType.description
+}
+
+// FirewallAliasContentError formats the error of invalid alias content, listing the
+// first invalid entries only, since aliases may hold thousands of entries.
+func FirewallAliasContentError(aliasType string, description string, invalid []string) string {
+	const maxListed = 10
+
+	listed := invalid
+	if len(listed) > maxListed {
+		listed = listed[:maxListed]
+	}
+
+	msg := fmt.Sprintf("The content of %s aliases accepts %s, got %d invalid entries: %s",
+		aliasType, description, len(invalid), strings.Join(listed, ", "))
+	if len(invalid) > maxListed {
+		msg += ", ..."
+	}
+	return msg
+}
+
+var _ validator.Set = aliasContentValidator{}
+
+// aliasContentValidator validates the entries of the content of an alias
+// against its type, read from the type attribute next to it.
+type aliasContentValidator struct{}
+
+func (v aliasContentValidator) Description(ctx context.Context) string {
+	return "value must only contain entries valid for the type of the alias"
+}
+
+func (v aliasContentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v aliasContentValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var aliasType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("type"), &aliasType)...)
+	if resp.Diagnostics.HasError() || aliasType.IsNull() || aliasType.IsUnknown() {
+		return
+	}
+
+	var content []string
+	for _, element := range req.ConfigValue.Elements() {
+		entry, ok := element.(types.String)
+		if !ok || entry.IsUnknown() {
+			continue
+		}
+		content = append(content, entry.ValueString())
+	}
+
+	if invalid, description := InvalidFirewallAliasContent(aliasType.ValueString(), content); len(invalid) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Alias Content",
+			FirewallAliasContentError(aliasType.ValueString(), description, invalid),
+		)
+	}
+}
+
+// IsFirewallAliasContent returns a validator which ensures that the entries of the
+// content of an alias are valid for its type (e.g. networks in CIDR notation
+// for network aliases). The type is read from the `type` attribute next to
+// the content.
+func IsFirewallAliasContent() validator.Set {
+	return aliasContentValidator{}
+}
+
+// Helpers
+
+func isIP(s string) bool {
+	return net.ParseIP(s) != nil
+}
+
+func isIPRange(s string) bool {
+	from, to, ok := strings.Cut(s, "-")
+	return ok && isIP(from) && isIP(to)
+}
+
+func portNumber(s string) int64 {
+	port, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return -1
+	}
+	return port
+}
+
+func isPort(s string) bool {
+	port := portNumber(s)
+	return port >= 1 && port <= 65535
+}
+
+func isURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https" || u.Scheme == "ftp"
+}
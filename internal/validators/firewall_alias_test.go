@@ -0,0 +1,105 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInvalidFirewallAliasContent(t *testing.T) {
+	cases := map[string]struct {
+		aliasType string
+		content   []string
+		invalid   []string
+	}{
+		"host": {
+			aliasType: "host",
+			content:   []string{"10.0.0.1", "fd00::1", "10.0.0.1-10.0.0.9", "nas.example.com", "my_alias", "!10.0.0.2"},
+		},
+		"host invalid": {
+			aliasType: "host",
+			content:   []string{"10.0.0.0/24", "!", "bad host"},
+			invalid:   []string{"10.0.0.0/24", "!", "bad host"},
+		},
+		"network": {
+			aliasType: "network",
+			content:   []string{"10.0.0.0/24", "fd00::/64", "10.0.0.1", "my_alias", "!10.0.1.0/24"},
+		},
+		"network invalid": {
+			aliasType: "network",
+			content:   []string{"10.0.0.0/33", "!example.com"},
+			invalid:   []string{"10.0.0.0/33", "!example.com"},
+		},
+		"port": {
+			aliasType: "port",
+			content:   []string{"443", "1", "65535", "8000:8080", "8080:8080", "my_alias"},
+		},
+		"port invalid": {
+			aliasType: "port",
+			content:   []string{"0", "70000", "9000:80", "80:70000", "80-90"},
+			invalid:   []string{"0", "70000", "9000:80", "80:70000", "80-90"},
+		},
+		"geoip": {
+			aliasType: "geoip",
+			content:   []string{"FR", "US"},
+		},
+		"geoip invalid": {
+			aliasType: "geoip",
+			content:   []string{"fr", "FRA"},
+			invalid:   []string{"fr", "FRA"},
+		},
+		"mac": {
+			aliasType: "mac",
+			content:   []string{"00:11:22:33:44:55", "00-11-22-33-44-55", "00:11:22"},
+		},
+		"mac invalid": {
+			aliasType: "mac",
+			content:   []string{"00:11", "00:11:22:33:44:55:66", "zz:11:22"},
+			invalid:   []string{"00:11", "00:11:22:33:44:55:66", "zz:11:22"},
+		},
+		"asn": {
+			aliasType: "asn",
+			content:   []string{"13335", "4294967295"},
+		},
+		"asn invalid": {
+			aliasType: "asn",
+			content:   []string{"0", "-1", "AS13335", "4294967296"},
+			invalid:   []string{"0", "-1", "AS13335", "4294967296"},
+		},
+		"url": {
+			aliasType: "url",
+			content:   []string{"https://example.com/list.txt", "ftp://example.com/list.txt"},
+		},
+		"url invalid": {
+			aliasType: "url",
+			content:   []string{"example.com/list.txt", "file:///etc/hosts"},
+			invalid:   []string{"example.com/list.txt", "file:///etc/hosts"},
+		},
+		"networkgroup": {
+			aliasType: "networkgroup",
+			content:   []string{"__lan_network", "my_alias"},
+		},
+		"networkgroup invalid": {
+			aliasType: "networkgroup",
+			content:   []string{"10.0.0.0/24"},
+			invalid:   []string{"10.0.0.0/24"},
+		},
+		"external": {
+			aliasType: "external",
+			content:   []string{"10.0.0.1", "my_alias"},
+			invalid:   []string{"10.0.0.1", "my_alias"},
+		},
+		"unknown type": {
+			aliasType: "dynipv6host",
+			content:   []string{"anything", "::1"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			invalid, _ := InvalidFirewallAliasContent(c.aliasType, c.content)
+			if !reflect.DeepEqual(invalid, c.invalid) {
+				t.Errorf("InvalidFirewallAliasContent(%q, %q) = %q, want %q", c.aliasType, c.content, invalid, c.invalid)
+			}
+		})
+	}
+}
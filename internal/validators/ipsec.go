@@ -0,0 +1,142 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strings"
+)
+
+// Algorithm keywords accepted by strongSwan in proposals, see
+// https://docs.strongswan.org/docs/5.9/config/proposals.html
+
+var ipsecEncryptionAlgorithms = map[string]bool{
+	"aes128": true, "aes192": true, "aes256": true,
+	"aes128ctr": true, "aes192ctr": true, "aes256ctr": true,
+	"camellia128": true, "camellia192": true, "camellia256": true,
+	"cast128": true, "blowfish128": true, "blowfish192": true, "blowfish256": true,
+	"3des": true, "null": true,
+}
+
+var ipsecAEADAlgorithms = map[string]bool{
+	"aes128gcm8": true, "aes192gcm8": true, "aes256gcm8": true,
+	"aes128gcm12": true, "aes192gcm12": true, "aes256gcm12": true,
+	"aes128gcm16": true, "aes192gcm16": true, "aes256gcm16": true,
+	"aes128ccm8": true, "aes192ccm8": true, "aes256ccm8": true,
+	"aes128ccm12": true, "aes192ccm12": true, "aes256ccm12": true,
+	"aes128ccm16": true, "aes192ccm16": true, "aes256ccm16": true,
+	"chacha20poly1305": true,
+}
+
+var ipsecIntegrityAlgorithms = map[string]bool{
+	"md5": true, "sha1": true, "sha256": true, "sha384": true, "sha512": true,
+	"sha256_96": true, "aesxcbc": true, "aescmac": true,
+}
+
+var ipsecPRFAlgorithms = map[string]bool{
+	"prfmd5": true, "prfsha1": true, "prfsha256": true, "prfsha384": true, "prfsha512": true,
+	"prfaesxcbc": true, "prfaescmac": true,
+}
+
+var ipsecDHGroups = map[string]bool{
+	"modp768": true, "modp1024": true, "modp1536": true, "modp2048": true,
+	"modp3072": true, "modp4096": true, "modp6144": true, "modp8192": true,
+	"modp1024s160": true, "modp2048s224": true, "modp2048s256": true,
+	"ecp192": true, "ecp224": true, "ecp256": true, "ecp384": true, "ecp521": true,
+	"ecp224bp": true, "ecp256bp": true, "ecp384bp": true, "ecp512bp": true,
+	"curve25519": true, "x25519": true, "curve448": true, "x448": true,
+}
+
+var ipsecESNModes = map[string]bool{
+	"esn": true, "noesn": true,
+}
+
+var _ validator.String = ipsecProposalValidator{}
+
+// ipsecProposalValidator validates that a string is a strongSwan proposal,
+// e.g. `aes256-sha256-modp2048`.
+type ipsecProposalValidator struct {
+	esp bool
+}
+
+func (v ipsecProposalValidator) Description(ctx context.Context) string {
+	if v.esp {
+		return "value must be `default` or an ESP proposal of an encryption algorithm, an integrity algorithm (unless using AEAD) and optionally a DH group, e.g. `aes256-sha256-modp2048` or `aes256gcm16`"
+	}
+	return "value must be `default` or an IKE proposal of an encryption algorithm, an integrity algorithm (unless using AEAD) and a DH group, e.g. `aes256-sha256-modp2048` or `aes256gcm16-prfsha256-ecp256`"
+}
+
+func (v ipsecProposalValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipsecProposalValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "default" {
+		return
+	}
+
+	if err := v.validate(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPsec Proposal",
+			fmt.Sprintf("Attribute %s %s, got: %s (%s)", req.Path, v.Description(ctx), value, err),
+		)
+	}
+}
+
+func (v ipsecProposalValidator) validate(proposal string) error {
+	var encryption, aead, integrity, dh int
+
+	for _, token := range strings.Split(proposal, "-") {
+		switch {
+		case ipsecEncryptionAlgorithms[token]:
+			encryption++
+		case ipsecAEADAlgorithms[token]:
+			aead++
+		case ipsecIntegrityAlgorithms[token]:
+			integrity++
+		case ipsecDHGroups[token]:
+			dh++
+		case ipsecPRFAlgorithms[token]:
+			if v.esp {
+				return fmt.Errorf("PRF algorithm %q is only valid in IKE proposals", token)
+			}
+		case ipsecESNModes[token]:
+			if !v.esp {
+				return fmt.Errorf("%q is only valid in ESP proposals", token)
+			}
+		default:
+			return fmt.Errorf("unknown algorithm %q", token)
+		}
+	}
+
+	switch {
+	case encryption == 0 && aead == 0:
+		return fmt.Errorf("missing encryption algorithm")
+	case encryption > 0 && aead > 0:
+		return fmt.Errorf("AEAD and classic encryption algorithms cannot be combined")
+	case encryption > 0 && integrity == 0:
+		return fmt.Errorf("missing integrity algorithm")
+	case !v.esp && dh == 0:
+		return fmt.Errorf("missing DH group")
+	}
+
+	return nil
+}
+
+// IsIPsecIKEProposal returns a validator which ensures that any configured
+// string value is an IKE (phase 1) proposal.
+func IsIPsecIKEProposal() validator.String {
+	return ipsecProposalValidator{esp: false}
+}
+
+// IsIPsecESPProposal returns a validator which ensures that any configured
+// string value is an ESP (phase 2) proposal.
+func IsIPsecESPProposal() validator.String {
+	return ipsecProposalValidator{esp: true}
+}
@@ -0,0 +1,110 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"net"
+)
+
+var _ validator.String = cidrValidator{}
+
+// cidrValidator validates that a string is a network in CIDR notation.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be a network in CIDR notation, e.g. `192.168.1.0/24` or `fd00::/64`"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// IsCIDR returns a validator which ensures that any configured string value
+// is a network in CIDR notation.
+func IsCIDR() validator.String {
+	return cidrValidator{}
+}
+
+var _ validator.String = ipValidator{}
+
+// ipValidator validates that a string is an IPv4 or IPv6 address.
+type ipValidator struct{}
+
+func (v ipValidator) Description(ctx context.Context) string {
+	return "value must be an IP address, e.g. `192.168.1.1` or `fd00::1`"
+}
+
+func (v ipValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// IsIP returns a validator which ensures that any configured string value
+// is an IPv4 or IPv6 address.
+func IsIP() validator.String {
+	return ipValidator{}
+}
+
+var _ validator.String = macValidator{}
+
+// macValidator validates that a string is a MAC address.
+type macValidator struct{}
+
+func (v macValidator) Description(ctx context.Context) string {
+	return "value must be a MAC address, e.g. `00:11:22:33:44:55`"
+}
+
+func (v macValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v macValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if hw, err := net.ParseMAC(value); err != nil || len(hw) != 6 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MAC Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// IsMAC returns a validator which ensures that any configured string value
+// is a (48 bit) MAC address.
+func IsMAC() validator.String {
+	return macValidator{}
+}